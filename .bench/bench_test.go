@@ -3,9 +3,12 @@ package bench
 import (
 	"bytes"
 	"compress/gzip"
+	"io"
 	"io/ioutil"
 	"os"
 	"testing"
+
+	"github.com/benbjohnson/megajson/scanner"
 )
 
 var codeJSON []byte
@@ -88,3 +91,57 @@ func BenchmarkCodeDecoder(b *testing.B) {
 	}
 	b.SetBytes(int64(len(codeJSON)))
 }
+
+func BenchmarkCodeDecodeBytes(b *testing.B) {
+	if codeJSON == nil {
+		b.StopTimer()
+		codeInit()
+		b.StartTimer()
+	}
+	var dec codeResponseJSONDecoder
+	r := &codeResponse{}
+	for i := 0; i < b.N; i++ {
+		if err := dec.DecodeBytes(codeJSON, &r); err != nil {
+			b.Fatal("DecodeBytes:", err)
+		}
+	}
+	b.SetBytes(int64(len(codeJSON)))
+}
+
+func BenchmarkCodeScan(b *testing.B) {
+	if codeJSON == nil {
+		b.StopTimer()
+		codeInit()
+		b.StartTimer()
+	}
+	for i := 0; i < b.N; i++ {
+		s := scanner.NewScanner(bytes.NewReader(codeJSON))
+		for {
+			if _, _, err := s.Scan(); err == io.EOF {
+				break
+			} else if err != nil {
+				b.Fatal("Scan:", err)
+			}
+		}
+	}
+	b.SetBytes(int64(len(codeJSON)))
+}
+
+func BenchmarkCodeScanBytes(b *testing.B) {
+	if codeJSON == nil {
+		b.StopTimer()
+		codeInit()
+		b.StartTimer()
+	}
+	for i := 0; i < b.N; i++ {
+		s := scanner.NewBytesScanner(codeJSON)
+		for {
+			if _, _, err := s.Scan(); err == io.EOF {
+				break
+			} else if err != nil {
+				b.Fatal("Scan:", err)
+			}
+		}
+	}
+	b.SetBytes(int64(len(codeJSON)))
+}
@@ -9,88 +9,214 @@ import (
 // tmplsrc returns raw, uncompressed file data.
 func tmplsrc() []byte {
 	gz, err := gzip.NewReader(bytes.NewBuffer([]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x00, 0xff, 0xbc, 0x57,
-		0xdf, 0x4f, 0xe3, 0x38, 0x10, 0x7e, 0x4e, 0xfe, 0x8a, 0x21, 0x12, 0x90,
-		0xb0, 0x28, 0x45, 0x77, 0xab, 0x7d, 0xe0, 0xd4, 0x07, 0x96, 0x63, 0x4f,
-		0x7b, 0xd7, 0x2d, 0x27, 0xa0, 0x4f, 0x68, 0x75, 0x72, 0x53, 0x17, 0x4c,
-		0x5b, 0x3b, 0x67, 0xbb, 0xdd, 0x45, 0xb9, 0xfe, 0xef, 0x37, 0x63, 0x37,
-		0xfd, 0x91, 0x92, 0x20, 0xa0, 0xbb, 0x2f, 0xc5, 0xb5, 0x67, 0xe6, 0xfb,
-		0x66, 0xbe, 0x31, 0x9e, 0xe6, 0x2c, 0x1b, 0xb1, 0x3b, 0x0e, 0x45, 0x91,
-		0x76, 0xd9, 0x84, 0xbb, 0x8f, 0xf9, 0x3c, 0x0c, 0xc5, 0x24, 0x57, 0xda,
-		0x42, 0x1c, 0x06, 0x11, 0xd7, 0x5a, 0x69, 0x13, 0xe1, 0x6a, 0x38, 0xb1,
-		0xf4, 0x47, 0x28, 0xfa, 0xbc, 0x13, 0xf6, 0x7e, 0xda, 0x4f, 0x33, 0x35,
-		0x69, 0xf5, 0xb9, 0xec, 0x3f, 0xa8, 0x7b, 0x69, 0x94, 0x6c, 0x4d, 0xf8,
-		0x1d, 0x7b, 0xa0, 0x85, 0xc9, 0x98, 0x94, 0x5c, 0x47, 0x61, 0x12, 0x86,
-		0x45, 0xa1, 0x99, 0x44, 0x14, 0xfb, 0x98, 0x73, 0x03, 0x29, 0x02, 0xd0,
-		0xaa, 0x02, 0xfa, 0xe7, 0xf5, 0x65, 0xf7, 0x77, 0x9e, 0xa9, 0x01, 0xd7,
-		0x60, 0xac, 0x9e, 0x66, 0x16, 0x8a, 0x30, 0x30, 0xb0, 0x08, 0x94, 0x5e,
-		0xfb, 0xbf, 0x21, 0xb2, 0x1b, 0x4e, 0x65, 0x06, 0x5d, 0xfe, 0xad, 0x36,
-		0x40, 0xac, 0x41, 0xa8, 0xf4, 0x8a, 0x33, 0x5c, 0x27, 0x70, 0x54, 0x0f,
-		0x84, 0x08, 0x9a, 0xdb, 0xa9, 0x96, 0x70, 0x50, 0x6b, 0x54, 0x98, 0xd3,
-		0x25, 0x09, 0x04, 0x5d, 0xf0, 0x88, 0x75, 0x32, 0x6f, 0xe6, 0x42, 0x86,
-		0x25, 0x9f, 0xad, 0x34, 0x76, 0xc3, 0x6a, 0xc5, 0x20, 0xe6, 0x0d, 0x01,
-		0x13, 0xf0, 0x8b, 0x38, 0xb7, 0x1a, 0x8e, 0x2a, 0x76, 0x09, 0x38, 0x85,
-		0x7d, 0xb1, 0x4f, 0xdb, 0xc0, 0x53, 0x13, 0x06, 0x62, 0x08, 0x56, 0x8d,
-		0x8e, 0xe9, 0x63, 0xc6, 0xc6, 0xc7, 0x64, 0x42, 0x67, 0xc6, 0xd1, 0x8f,
-		0x93, 0xdf, 0xdc, 0xc6, 0x5e, 0x1b, 0xa4, 0x18, 0x93, 0x63, 0x49, 0x17,
-		0x77, 0xc3, 0x60, 0x0e, 0x7c, 0x6c, 0x38, 0xf8, 0x10, 0xd0, 0x6e, 0x2f,
-		0x53, 0xbf, 0xe9, 0xf6, 0x3a, 0x1d, 0x67, 0x7e, 0x44, 0x44, 0x9c, 0xf7,
-		0xca, 0xd7, 0x7d, 0xd9, 0xf4, 0xdd, 0x5b, 0xf3, 0xed, 0x7c, 0xbc, 0x3a,
-		0x3b, 0xbf, 0x58, 0x07, 0xc3, 0x76, 0x4c, 0x2f, 0x88, 0xfa, 0x30, 0x8e,
-		0x7a, 0x92, 0x7f, 0xcf, 0x79, 0x66, 0xf9, 0x00, 0xf6, 0x0d, 0x30, 0x0b,
-		0xfb, 0x83, 0x53, 0x5c, 0x21, 0xcf, 0x72, 0xfb, 0xb0, 0x38, 0x8c, 0x8e,
-		0x57, 0xe1, 0xd4, 0x88, 0x4b, 0xca, 0x3f, 0x46, 0x9c, 0x04, 0xf7, 0xd3,
-		0xbf, 0x95, 0x89, 0x69, 0x61, 0xb5, 0x90, 0x77, 0xb1, 0xcf, 0x3b, 0x49,
-		0x90, 0x51, 0x18, 0x06, 0xad, 0x16, 0x9c, 0x6b, 0xce, 0x2c, 0x76, 0xf0,
-		0x3d, 0x07, 0xd5, 0x7f, 0xc0, 0x90, 0xc4, 0x51, 0x58, 0x18, 0x28, 0x6e,
-		0xe4, 0xa1, 0x45, 0x1c, 0x61, 0x6c, 0xea, 0x0a, 0xe7, 0x93, 0x5b, 0xd5,
-		0x66, 0x91, 0x6c, 0x45, 0xca, 0x62, 0x4e, 0xb1, 0x83, 0x19, 0x95, 0x95,
-		0x2c, 0x3c, 0x4c, 0x47, 0xa9, 0x1c, 0xd4, 0x0c, 0x7b, 0x60, 0xc4, 0x1f,
-		0x5b, 0x48, 0x61, 0xca, 0x21, 0x67, 0x42, 0x1b, 0x0a, 0x2d, 0x07, 0xfc,
-		0x3b, 0x99, 0x9f, 0x84, 0xc1, 0xd0, 0x0b, 0x46, 0x2e, 0xd4, 0xe5, 0x20,
-		0x24, 0x39, 0xa0, 0x51, 0x30, 0x63, 0xce, 0x77, 0x91, 0x08, 0x6e, 0x34,
-		0xe9, 0x88, 0xc7, 0x48, 0xb8, 0xa2, 0xe5, 0x86, 0x98, 0x0d, 0x6a, 0x5e,
-		0xad, 0x14, 0xd9, 0xd0, 0xb0, 0xc1, 0xe5, 0xfc, 0xf2, 0xcb, 0x97, 0x33,
-		0xef, 0x41, 0xe5, 0x73, 0x09, 0xe1, 0xf9, 0x89, 0xdf, 0x7a, 0x46, 0x58,
-		0xfc, 0x57, 0x33, 0x61, 0x5e, 0xdb, 0x68, 0xa9, 0x18, 0xa5, 0x40, 0x75,
-		0x0c, 0x9e, 0x6c, 0xd9, 0x86, 0x8e, 0xad, 0xa4, 0xe9, 0x62, 0x90, 0xd6,
-		0xc1, 0x13, 0xbd, 0x77, 0x7d, 0x73, 0xf5, 0xb9, 0xfb, 0xc7, 0x46, 0xa6,
-		0x2f, 0x6e, 0x3e, 0x50, 0x7a, 0xa1, 0xc9, 0xab, 0xda, 0xb0, 0x2c, 0xaa,
-		0xe3, 0x40, 0xfa, 0xb6, 0x2b, 0x36, 0x25, 0xfd, 0xb5, 0x8e, 0xa0, 0x66,
-		0xcd, 0xd4, 0x58, 0xc9, 0x34, 0x7c, 0xba, 0x3c, 0x4d, 0x37, 0xba, 0xa9,
-		0x0b, 0xf6, 0x36, 0x24, 0xed, 0x5c, 0x76, 0xdf, 0x50, 0x1a, 0x47, 0xf0,
-		0x95, 0x25, 0xa1, 0x7c, 0xcd, 0x37, 0x61, 0xb3, 0x7b, 0xd7, 0xf2, 0x44,
-		0xa2, 0x7c, 0x6a, 0x86, 0x82, 0x8f, 0x07, 0xfe, 0xad, 0x09, 0x68, 0x17,
-		0x99, 0xa3, 0x89, 0xc4, 0xb8, 0xe5, 0x5e, 0xc6, 0xa8, 0x9c, 0xc5, 0x72,
-		0x17, 0xfe, 0x83, 0x1c, 0x11, 0xec, 0x10, 0xa2, 0xfd, 0x7f, 0xa3, 0xf9,
-		0xfc, 0xd4, 0x35, 0x89, 0xbb, 0x9f, 0x07, 0xb3, 0xb4, 0x28, 0x5c, 0xc4,
-		0x65, 0x00, 0x77, 0xe8, 0xc2, 0x0a, 0x83, 0x6e, 0x13, 0x61, 0xc5, 0x8c,
-		0xbb, 0x57, 0x6d, 0x11, 0x7e, 0x79, 0xea, 0x37, 0x21, 0x5a, 0x88, 0x5f,
-		0x9e, 0x96, 0x17, 0xcf, 0x69, 0x40, 0x8a, 0x5d, 0xfb, 0xfc, 0x66, 0x4f,
-		0xb5, 0xea, 0x56, 0xb7, 0x96, 0x4d, 0x4f, 0x28, 0x5c, 0x0e, 0x6a, 0x20,
-		0x31, 0x9b, 0x7a, 0xbc, 0xcf, 0xd2, 0xee, 0x1a, 0xec, 0xc3, 0xfb, 0x46,
-		0xb8, 0x0f, 0xef, 0x77, 0x0a, 0x38, 0x6d, 0x4c, 0xaf, 0x27, 0x76, 0x9c,
-		0xdf, 0xf4, 0x99, 0x04, 0x7b, 0x62, 0xe7, 0x19, 0x0e, 0xc7, 0x8a, 0xd9,
-		0x5f, 0x7f, 0xa9, 0xc7, 0xfc, 0xe4, 0x0d, 0x76, 0x0f, 0xda, 0x94, 0xe8,
-		0x27, 0x6f, 0xb0, 0x53, 0xd0, 0xbe, 0x52, 0xe3, 0x7a, 0xc4, 0x8f, 0x78,
-		0xfa, 0x26, 0xb8, 0xcd, 0xf5, 0x06, 0xf2, 0xd1, 0x12, 0x76, 0x85, 0xea,
-		0xc6, 0x39, 0x33, 0xed, 0x97, 0xf7, 0x79, 0x6b, 0x9a, 0x4b, 0xd2, 0xc5,
-		0x40, 0x55, 0x43, 0xaa, 0xca, 0xe9, 0x59, 0x16, 0xb7, 0x5f, 0xdf, 0x42,
-		0xe3, 0x4c, 0x6b, 0xf6, 0xf8, 0x6a, 0x2e, 0xab, 0xe5, 0x6a, 0xe5, 0x5f,
-		0x42, 0x7a, 0x9f, 0xdf, 0xbd, 0xf3, 0x33, 0xd0, 0xda, 0x0b, 0xff, 0xa2,
-		0x99, 0xd3, 0x73, 0x73, 0x83, 0xe7, 0xed, 0xd7, 0x97, 0x8c, 0x9e, 0xff,
-		0xbc, 0x65, 0xea, 0xdc, 0x9a, 0x1c, 0xff, 0xba, 0xb8, 0xa9, 0xb8, 0xe0,
-		0x8f, 0x1a, 0x1a, 0xe7, 0xe3, 0xe8, 0x62, 0xf9, 0x4a, 0xdf, 0x1e, 0x46,
-		0x8b, 0x89, 0xcf, 0x8c, 0x45, 0xc6, 0x09, 0x7b, 0xc2, 0x46, 0x3c, 0xde,
-		0x22, 0x7e, 0x0c, 0x27, 0x49, 0x75, 0x60, 0x13, 0x96, 0x4f, 0xea, 0xc6,
-		0xb4, 0x1f, 0x3b, 0x83, 0x95, 0xb9, 0x95, 0x93, 0xa6, 0x23, 0xff, 0x73,
-		0xa7, 0x32, 0x9c, 0x36, 0x18, 0x09, 0xfd, 0x83, 0xc7, 0x33, 0x7c, 0xee,
-		0xd3, 0x9e, 0x24, 0xe2, 0xf1, 0x5a, 0xb0, 0xc4, 0x35, 0x2b, 0x96, 0xdf,
-		0xbd, 0xd5, 0xdb, 0x63, 0xf6, 0xda, 0x95, 0xe2, 0xe5, 0xc5, 0x3d, 0x20,
-		0xfb, 0x67, 0xa7, 0x1e, 0x02, 0x74, 0x9d, 0xd0, 0x06, 0x96, 0xe7, 0x78,
-		0x39, 0x62, 0xf7, 0xf5, 0xd8, 0x89, 0x9d, 0x54, 0xee, 0xc8, 0x9c, 0x7e,
-		0xe8, 0xfa, 0x0b, 0xf4, 0x7f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xda, 0xa5,
-		0x93, 0x69, 0x57, 0x0f, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a,
+		0x5f, 0x73, 0xdb, 0xb8, 0x11, 0x7f, 0x06, 0x3f, 0xc5, 0x46, 0xd3, 0x3a,
+		0x94, 0xa3, 0xa3, 0xd2, 0xd7, 0x5c, 0xd5, 0x99, 0x5c, 0xe2, 0xeb, 0xb8,
+		0x49, 0x9c, 0x6b, 0xec, 0xbc, 0xd4, 0xe3, 0x07, 0x88, 0x5c, 0x49, 0x8c,
+		0x28, 0x40, 0x07, 0x42, 0xb2, 0x75, 0x0c, 0xbf, 0x7b, 0x67, 0x01, 0xf0,
+		0xaf, 0x28, 0x99, 0xca, 0xf9, 0x32, 0xc9, 0x83, 0x25, 0x91, 0xc0, 0x62,
+		0xff, 0xff, 0x76, 0x17, 0x19, 0x8f, 0xe1, 0x8d, 0x8c, 0x10, 0xe6, 0x28,
+		0x50, 0x71, 0x8d, 0x11, 0x4c, 0x77, 0xb0, 0xc2, 0x39, 0xff, 0x92, 0x4a,
+		0x11, 0xc0, 0xdb, 0x8f, 0x70, 0xf5, 0xf1, 0x06, 0x2e, 0xde, 0x5e, 0xde,
+		0x04, 0x9e, 0xb7, 0xe6, 0xe1, 0x92, 0xcf, 0x11, 0xb2, 0x2c, 0xb8, 0xe2,
+		0x2b, 0xcc, 0x73, 0xcf, 0x8b, 0x57, 0x6b, 0xa9, 0x34, 0xf8, 0x1e, 0x1b,
+		0xa0, 0x08, 0x65, 0x14, 0x8b, 0xf9, 0x98, 0xb6, 0x0e, 0x3c, 0x36, 0x88,
+		0x25, 0xfd, 0x4d, 0xb5, 0x0a, 0xa5, 0xd8, 0xd2, 0xd7, 0x79, 0xac, 0x17,
+		0x9b, 0x69, 0x10, 0xca, 0xd5, 0x78, 0x8a, 0x62, 0xfa, 0x45, 0x2e, 0x44,
+		0x2a, 0xc5, 0xb8, 0x38, 0x6e, 0x9c, 0x86, 0x5c, 0x08, 0x54, 0x03, 0x8f,
+		0x65, 0xd9, 0x4f, 0xa0, 0xb8, 0x98, 0x23, 0x04, 0x97, 0xe6, 0x84, 0x34,
+		0xcf, 0x3d, 0x56, 0x9e, 0x4b, 0x1c, 0xfc, 0xc6, 0xf5, 0x02, 0xbe, 0xc2,
+		0x5a, 0xc5, 0x42, 0xcf, 0x60, 0xf0, 0xf7, 0xdf, 0x07, 0x76, 0xc9, 0x4f,
+		0x80, 0x22, 0xca, 0x73, 0x6f, 0xe8, 0x79, 0x59, 0xe6, 0x68, 0xbc, 0xc5,
+		0x50, 0x46, 0xa8, 0x88, 0x88, 0xde, 0xad, 0x6b, 0x02, 0xfc, 0xe7, 0xfa,
+		0xe3, 0x95, 0x7b, 0x09, 0xa9, 0x56, 0x9b, 0x50, 0x43, 0xe6, 0xb1, 0x14,
+		0xcc, 0x3f, 0xc7, 0x4e, 0x70, 0x6d, 0x3f, 0x3d, 0x96, 0x6a, 0x15, 0x87,
+		0x1a, 0xa6, 0x52, 0x26, 0x1e, 0x5b, 0xf1, 0x07, 0x5a, 0x14, 0x0b, 0xed,
+		0xe5, 0x9e, 0x37, 0xdb, 0x88, 0x10, 0xae, 0xf0, 0xbe, 0x8b, 0xb2, 0xaf,
+		0x20, 0x96, 0xc1, 0x27, 0xe4, 0x11, 0xaa, 0x21, 0x9c, 0x77, 0x1e, 0x9e,
+		0x79, 0x4c, 0xa1, 0xde, 0x28, 0x01, 0x67, 0x5d, 0xef, 0xb3, 0xf4, 0x55,
+		0xc9, 0xce, 0x15, 0xde, 0x3b, 0x8e, 0x7c, 0x35, 0xcc, 0x0f, 0x1e, 0x4e,
+		0x6b, 0x0a, 0x06, 0xd2, 0xb6, 0x2c, 0x7f, 0x86, 0x0d, 0x73, 0xe4, 0x78,
+		0x0c, 0xd7, 0x56, 0x1b, 0x2b, 0xbe, 0xc4, 0x14, 0xf4, 0x02, 0x21, 0x72,
+		0x44, 0x1c, 0x05, 0x2e, 0x00, 0x95, 0x92, 0x0a, 0x66, 0x52, 0xc1, 0x46,
+		0x2c, 0x85, 0xbc, 0x17, 0x30, 0x8b, 0x31, 0x89, 0xd2, 0x11, 0x6c, 0x79,
+		0xb2, 0xc1, 0x14, 0xe4, 0x8c, 0x76, 0x12, 0xb5, 0x7b, 0x25, 0xc5, 0x1c,
+		0x8c, 0x75, 0xb8, 0x88, 0x80, 0x8b, 0x1d, 0x44, 0x5c, 0x73, 0xe0, 0x33,
+		0x8d, 0xaa, 0x46, 0x3e, 0xb2, 0x5b, 0x03, 0x2b, 0xb4, 0x8f, 0xdd, 0x82,
+		0x0c, 0x1d, 0x77, 0xfe, 0x90, 0x44, 0x8a, 0x67, 0x80, 0x41, 0x0a, 0xcf,
+		0x26, 0x20, 0xe2, 0x84, 0x1e, 0x30, 0x0c, 0xd2, 0xe0, 0x1a, 0xb5, 0x5b,
+		0xa4, 0xd5, 0x06, 0x87, 0x1e, 0xcb, 0x3d, 0x7a, 0x6e, 0x1e, 0xc1, 0x04,
+		0xe8, 0xa1, 0x93, 0xf4, 0x03, 0x7f, 0xb8, 0x91, 0x4b, 0x14, 0xd7, 0xf1,
+		0x1f, 0xd8, 0x53, 0x5e, 0xe2, 0x9f, 0x48, 0x89, 0x39, 0x48, 0x05, 0x62,
+		0xb3, 0x9a, 0xa2, 0x22, 0x52, 0xb1, 0x30, 0x5b, 0x63, 0xb1, 0xde, 0x68,
+		0xb8, 0x5f, 0xc4, 0xe1, 0x02, 0xe2, 0x14, 0x12, 0xae, 0xe6, 0x46, 0x4a,
+		0x2e, 0x40, 0xc0, 0x74, 0xa7, 0x31, 0x0d, 0xe0, 0x7f, 0xa8, 0xe4, 0xc8,
+		0x1d, 0x34, 0xe3, 0x9b, 0x44, 0x8f, 0x68, 0xa9, 0x90, 0x90, 0xc4, 0xab,
+		0x58, 0x3f, 0xaa, 0x80, 0x3a, 0xd3, 0xbe, 0x20, 0x3f, 0x3d, 0xae, 0x8b,
+		0xe6, 0xfa, 0x42, 0x1d, 0xe4, 0xe5, 0x13, 0x10, 0x4e, 0x11, 0x96, 0xf8,
+		0x2f, 0xc4, 0x9f, 0x93, 0x3e, 0x05, 0x6e, 0x0d, 0x02, 0x33, 0x25, 0x57,
+		0xc6, 0x64, 0xa3, 0x4a, 0x2e, 0xeb, 0x75, 0x11, 0x49, 0xbd, 0x4e, 0x78,
+		0x48, 0x72, 0xa7, 0x1a, 0x79, 0x44, 0xb4, 0xe4, 0x0c, 0xa6, 0x48, 0xfa,
+		0x09, 0xe5, 0x3a, 0xc6, 0x08, 0xf4, 0x42, 0xc9, 0xcd, 0x7c, 0x01, 0x1c,
+		0x94, 0x89, 0x94, 0x00, 0x6e, 0x6a, 0x3a, 0x0e, 0xb9, 0x80, 0x29, 0x82,
+		0xc2, 0x4d, 0x8a, 0x91, 0xd1, 0x30, 0xf2, 0x70, 0xd1, 0xd3, 0x17, 0x6a,
+		0x6c, 0xfb, 0xc6, 0xa9, 0x6e, 0xef, 0x48, 0xc7, 0x23, 0x58, 0x6b, 0x05,
+		0xe7, 0xd5, 0xa6, 0xa1, 0x33, 0x60, 0x66, 0x1c, 0x01, 0x26, 0xf5, 0x90,
+		0x33, 0xbb, 0x8b, 0xb8, 0x23, 0x22, 0x43, 0xaf, 0xe5, 0x45, 0x85, 0xef,
+		0x0c, 0xbd, 0x4e, 0x95, 0x1a, 0x5d, 0x0e, 0xcb, 0xf8, 0x42, 0x97, 0x94,
+		0xfc, 0xb5, 0x56, 0xc3, 0x32, 0x88, 0x1f, 0x93, 0xc1, 0x3f, 0xcc, 0x31,
+		0x19, 0x56, 0x29, 0x78, 0x35, 0x01, 0x0c, 0xa2, 0x8a, 0xf4, 0xcf, 0xe6,
+		0x69, 0xcd, 0xdc, 0xc5, 0xf9, 0x4a, 0x79, 0x2c, 0x07, 0x4c, 0x52, 0x04,
+		0xeb, 0x13, 0xd6, 0xf1, 0xeb, 0x4b, 0x82, 0x34, 0xb8, 0x10, 0x91, 0x6f,
+		0x7d, 0xc1, 0x3d, 0x14, 0x71, 0xd2, 0x83, 0xdb, 0xe8, 0x31, 0x6e, 0x53,
+		0xcb, 0x68, 0x6a, 0xf8, 0xd6, 0x72, 0x39, 0xa2, 0x3f, 0x5b, 0x9e, 0x8c,
+		0x0a, 0x21, 0x52, 0x93, 0xaa, 0xfc, 0xbe, 0xfc, 0x6b, 0xb9, 0x84, 0x49,
+		0x65, 0xb0, 0x9b, 0xab, 0xcf, 0xef, 0xdf, 0x9b, 0xe5, 0xe7, 0xc4, 0x83,
+		0xd9, 0x5d, 0xed, 0x35, 0x3f, 0x9a, 0x7b, 0x9f, 0xd5, 0xf6, 0xbe, 0xff,
+		0xe5, 0xd3, 0xeb, 0x37, 0x17, 0xf5, 0xc3, 0xd2, 0xe0, 0xb3, 0xc0, 0x87,
+		0x35, 0x86, 0x1a, 0x23, 0xbf, 0xc1, 0xed, 0xe0, 0x79, 0xf6, 0x7c, 0x60,
+		0x14, 0xe4, 0x31, 0xc2, 0x52, 0x85, 0x5c, 0xa3, 0x09, 0x5a, 0x39, 0xfd,
+		0x82, 0xa1, 0x26, 0xfa, 0xb1, 0x86, 0x48, 0x62, 0x2a, 0x9e, 0x6b, 0xc0,
+		0x87, 0x38, 0xd5, 0x81, 0x11, 0xda, 0x32, 0x56, 0xc9, 0x65, 0x7f, 0xd7,
+		0xf2, 0x6e, 0x96, 0x1b, 0xbd, 0x6f, 0x49, 0x1b, 0xf4, 0xd2, 0x9e, 0xf0,
+		0x5e, 0xca, 0x35, 0xc8, 0x2d, 0x2a, 0x58, 0xe2, 0x6e, 0x6c, 0x43, 0x6f,
+		0xcd, 0x63, 0x95, 0x12, 0x55, 0x11, 0xe1, 0x03, 0x2d, 0x7f, 0xe9, 0xb1,
+		0x99, 0xd5, 0x33, 0x6d, 0x21, 0xe4, 0xa1, 0x10, 0x5c, 0xe2, 0x2e, 0xf0,
+		0x18, 0xdb, 0x72, 0xb3, 0xd7, 0xa5, 0x27, 0x8f, 0xb1, 0x63, 0xea, 0xf7,
+		0x58, 0xe1, 0x58, 0x35, 0x13, 0x34, 0x6c, 0x70, 0xc4, 0x08, 0x9f, 0x2a,
+		0x45, 0x36, 0x54, 0x7f, 0x64, 0xcb, 0x9b, 0x8f, 0x1f, 0x3e, 0xbc, 0xb6,
+		0x3b, 0x48, 0x73, 0x46, 0xa0, 0xc9, 0x04, 0x5e, 0xda, 0x47, 0x3d, 0xec,
+		0x61, 0xa5, 0x22, 0x93, 0x30, 0x96, 0x3b, 0x32, 0x7b, 0x02, 0x1e, 0x71,
+		0xaf, 0x96, 0x70, 0x2c, 0x6f, 0xb0, 0x6b, 0x19, 0xfa, 0x57, 0xc1, 0xcf,
+		0xe3, 0xec, 0x84, 0x72, 0xb5, 0xe2, 0x20, 0x15, 0x3c, 0xcf, 0x8d, 0x9f,
+		0x18, 0x47, 0x61, 0x1d, 0x4e, 0x77, 0x7d, 0xf3, 0xe9, 0xf2, 0xea, 0xdf,
+		0x7d, 0xc9, 0xd6, 0xa4, 0x74, 0xcc, 0x99, 0x8d, 0x64, 0xd6, 0x89, 0x33,
+		0xac, 0x6f, 0x17, 0x97, 0x67, 0xd6, 0x1c, 0x81, 0xdc, 0x33, 0x94, 0x89,
+		0x14, 0x81, 0xc7, 0x4e, 0x8e, 0xbf, 0x63, 0xc6, 0x7f, 0xd6, 0xb0, 0xe4,
+		0xfb, 0x8f, 0x57, 0xfd, 0xd5, 0x94, 0x48, 0x51, 0xe9, 0x27, 0xcb, 0xe2,
+		0x19, 0xcc, 0x64, 0x12, 0x2d, 0x71, 0x67, 0x2a, 0x3f, 0x66, 0x50, 0x58,
+		0x87, 0x0b, 0xf2, 0xdc, 0xd4, 0x01, 0x4c, 0x14, 0xcf, 0x66, 0xa8, 0x48,
+		0xa0, 0x90, 0xa7, 0x08, 0x49, 0xbc, 0x44, 0x68, 0x14, 0xa1, 0x81, 0x57,
+		0xaa, 0xc4, 0x71, 0xf5, 0xab, 0x4c, 0xa2, 0x77, 0xb8, 0xf3, 0x97, 0xb8,
+		0x1b, 0x41, 0x59, 0x1e, 0xfe, 0x6a, 0x8a, 0x92, 0x3c, 0x37, 0xc7, 0x06,
+		0xef, 0x70, 0x47, 0x5f, 0xe9, 0xb3, 0x5d, 0x5f, 0xd2, 0x1e, 0x53, 0x5d,
+		0xba, 0x8f, 0xa1, 0xe1, 0xd5, 0x7c, 0xf5, 0x18, 0x4b, 0xef, 0x63, 0xc7,
+		0xa1, 0x11, 0x7b, 0x8f, 0xbc, 0xc7, 0x9c, 0x64, 0xf6, 0x08, 0xfa, 0x69,
+		0x18, 0xef, 0x3e, 0xeb, 0x15, 0xbd, 0xaf, 0x88, 0x5c, 0xac, 0xa6, 0x18,
+		0x45, 0x68, 0x4f, 0x32, 0x56, 0xdb, 0x06, 0x55, 0x3d, 0x3c, 0xa9, 0x3b,
+		0x30, 0x6b, 0xbc, 0x01, 0x81, 0xf7, 0x7e, 0x96, 0x61, 0x82, 0x2b, 0x08,
+		0x6e, 0xa8, 0xbe, 0xfa, 0x6a, 0xca, 0x2c, 0x61, 0x53, 0xb2, 0xd9, 0x91,
+		0xbb, 0xb3, 0x0a, 0x49, 0x98, 0xcd, 0x3a, 0x67, 0x35, 0x42, 0x9e, 0xc7,
+		0x4a, 0xf6, 0xff, 0xbb, 0x91, 0xba, 0xe4, 0x84, 0x65, 0xd9, 0xdf, 0x88,
+		0x1e, 0x6d, 0x30, 0xf4, 0xab, 0xe7, 0x14, 0x30, 0xa9, 0x88, 0x93, 0x84,
+		0x4f, 0x13, 0x6c, 0xbe, 0x2c, 0x77, 0x4d, 0xa0, 0xe2, 0xac, 0xda, 0x59,
+		0x31, 0x72, 0xba, 0x7f, 0xee, 0x85, 0xf0, 0xb1, 0x7c, 0x53, 0x0f, 0xbb,
+		0x47, 0x79, 0x36, 0x69, 0x7b, 0xdb, 0x52, 0x36, 0x63, 0xec, 0x7c, 0x5b,
+		0xaa, 0xb9, 0xd0, 0x2c, 0x18, 0xe9, 0x0a, 0xfd, 0x96, 0x2a, 0x6e, 0xc9,
+		0x46, 0x6e, 0x4d, 0x55, 0x8e, 0xab, 0x14, 0x57, 0x9b, 0x54, 0xc3, 0x42,
+		0x26, 0x11, 0xe0, 0x03, 0x0f, 0x75, 0xb2, 0x03, 0x29, 0xb0, 0xa8, 0x71,
+		0x4a, 0x0e, 0x9c, 0x02, 0x08, 0x81, 0xf7, 0x0b, 0xfb, 0x11, 0x6c, 0x09,
+		0x93, 0xf7, 0xd8, 0xa8, 0x90, 0xb7, 0x60, 0x43, 0xe3, 0x6a, 0x9d, 0x10,
+		0x58, 0x0d, 0x2c, 0x58, 0x0f, 0x8a, 0xb5, 0x1e, 0x6b, 0x28, 0xb1, 0xac,
+		0x01, 0xcc, 0xbf, 0xdc, 0x3f, 0x54, 0x13, 0xb9, 0x74, 0x33, 0x82, 0x03,
+		0x3a, 0x3c, 0xdf, 0x92, 0x13, 0xa6, 0x98, 0xe7, 0xdb, 0x22, 0x7a, 0x3a,
+		0x6d, 0x57, 0x9d, 0x4b, 0xfb, 0x2e, 0x88, 0xed, 0x66, 0xb2, 0x68, 0x4b,
+		0xd7, 0x0e, 0x9c, 0x92, 0xd5, 0x2e, 0xdb, 0x3f, 0xeb, 0xaa, 0x11, 0x4e,
+		0x47, 0x16, 0xc6, 0xf2, 0x03, 0x62, 0xd6, 0xb2, 0x71, 0xe9, 0x19, 0x71,
+		0x52, 0xee, 0xa9, 0x8c, 0x5f, 0x68, 0xc3, 0x3b, 0x68, 0x8f, 0xba, 0xf3,
+		0xd5, 0x76, 0x56, 0x5f, 0xab, 0x6f, 0xae, 0x43, 0x78, 0xe5, 0x00, 0x2f,
+		0x0d, 0xea, 0xed, 0x4f, 0x53, 0x3a, 0xd3, 0x87, 0x99, 0x94, 0x44, 0x59,
+		0xb0, 0x81, 0x93, 0x55, 0x68, 0x2d, 0xe3, 0x75, 0x5f, 0x6c, 0x34, 0x78,
+		0x46, 0xb0, 0xf8, 0xe2, 0x05, 0xd5, 0x2b, 0x7d, 0x2b, 0xd9, 0xd7, 0x4a,
+		0xf1, 0x9d, 0x2d, 0x10, 0x6f, 0xef, 0x7e, 0xa0, 0x12, 0xb1, 0x5d, 0x0b,
+		0xef, 0x87, 0x41, 0x7e, 0x5a, 0x21, 0xf9, 0xee, 0xe2, 0xa6, 0x6f, 0x29,
+		0x79, 0x5b, 0x96, 0x92, 0x69, 0x12, 0x87, 0x26, 0xa3, 0x52, 0xb7, 0xe9,
+		0xd7, 0x15, 0x34, 0x82, 0x97, 0xc3, 0x76, 0x25, 0x18, 0x6b, 0x5c, 0x1d,
+		0xaa, 0xff, 0xfe, 0xda, 0xe2, 0xae, 0x10, 0xae, 0xd0, 0xa1, 0xe1, 0xdb,
+		0x63, 0x1d, 0x6a, 0xdc, 0xd7, 0xa3, 0x75, 0xbb, 0xef, 0x55, 0x17, 0x9a,
+		0x2c, 0xfa, 0x03, 0x96, 0x85, 0x77, 0x45, 0x59, 0xc8, 0x18, 0xad, 0x27,
+		0x81, 0xeb, 0x6b, 0x87, 0x26, 0xbc, 0x34, 0xae, 0x0c, 0x1e, 0x37, 0x7a,
+		0x83, 0xae, 0x8e, 0xef, 0x8c, 0x96, 0x3e, 0x5a, 0xb3, 0xd1, 0x59, 0xc6,
+		0xc1, 0x26, 0xc0, 0xd7, 0x6b, 0x14, 0x91, 0x6f, 0x7e, 0x8e, 0x8c, 0x23,
+		0x0d, 0xf7, 0x03, 0xda, 0xa4, 0xba, 0x8d, 0x58, 0x71, 0x95, 0x2e, 0x78,
+		0x82, 0x2a, 0xcf, 0x5d, 0x8c, 0x6f, 0xa1, 0x1e, 0xb9, 0x9f, 0x8b, 0x15,
+		0x14, 0xee, 0xf5, 0x46, 0xbb, 0x16, 0xd4, 0x8e, 0x11, 0xbf, 0x7b, 0xbc,
+		0x94, 0x0f, 0x83, 0x76, 0xaf, 0x3e, 0x82, 0xb3, 0x2d, 0xf5, 0xc7, 0x45,
+		0xae, 0x2b, 0x3e, 0xbd, 0x2c, 0x8b, 0x70, 0x16, 0x8b, 0x2a, 0x5f, 0xda,
+		0xf1, 0x9f, 0x49, 0xca, 0x6b, 0x45, 0x23, 0x92, 0x78, 0x8b, 0x06, 0x1f,
+		0x82, 0xbc, 0xa9, 0xad, 0xd4, 0xcc, 0xe2, 0xb2, 0x6c, 0x19, 0x8b, 0x08,
+		0x02, 0xf8, 0x0a, 0x2b, 0xd4, 0x0b, 0x19, 0xd9, 0x82, 0xc8, 0xcf, 0xb2,
+		0xb5, 0x9d, 0x58, 0x42, 0x00, 0x83, 0xed, 0xa0, 0x13, 0xa6, 0xda, 0xea,
+		0x2c, 0x98, 0x2a, 0xce, 0xb7, 0xc7, 0xc2, 0xe0, 0x7c, 0xd0, 0x3a, 0xda,
+		0x0c, 0xe9, 0xd2, 0xcd, 0xb4, 0xe0, 0x6b, 0x6f, 0x50, 0x57, 0x28, 0xe0,
+		0x49, 0xd9, 0xb0, 0x93, 0xcd, 0x6e, 0x5e, 0xcc, 0x2b, 0x12, 0xfd, 0x38,
+		0x3b, 0x67, 0xdb, 0x3f, 0x71, 0xfe, 0xed, 0xdd, 0x37, 0xeb, 0xc1, 0xc2,
+		0xc4, 0x53, 0x2a, 0xc3, 0x4c, 0xd7, 0x06, 0x9d, 0x2e, 0x61, 0xbd, 0xee,
+		0x49, 0x35, 0x4f, 0x71, 0x55, 0x1e, 0xf6, 0x64, 0x7d, 0x56, 0x27, 0x8c,
+		0x15, 0x67, 0x9b, 0x33, 0x9d, 0xd3, 0x37, 0x2b, 0x90, 0x46, 0xc9, 0x31,
+		0x1e, 0x83, 0x51, 0x6e, 0x0a, 0x5c, 0x21, 0x24, 0x38, 0xd3, 0xb0, 0x11,
+		0xe1, 0x82, 0xba, 0x0d, 0x73, 0x09, 0x20, 0x36, 0x49, 0x32, 0x3a, 0xd0,
+		0x54, 0x55, 0xf2, 0xf6, 0x45, 0xbf, 0xfe, 0xf0, 0xd7, 0x68, 0x68, 0x3b,
+		0x45, 0x32, 0x9d, 0xec, 0x26, 0xb5, 0x63, 0x16, 0xfb, 0xca, 0x4e, 0x59,
+		0x78, 0x42, 0xa3, 0xc3, 0x9d, 0x9d, 0xb2, 0xa4, 0x86, 0xd1, 0x0a, 0x49,
+		0xfd, 0xf3, 0xed, 0xf0, 0xf6, 0xd5, 0xcb, 0xbb, 0xa2, 0x7d, 0xb2, 0x2f,
+		0x9a, 0xb5, 0xbc, 0x7b, 0x56, 0x2f, 0x31, 0x03, 0x97, 0x6c, 0x1d, 0x6c,
+		0x94, 0xe9, 0x87, 0xb1, 0x6e, 0x0c, 0x66, 0x0d, 0x14, 0x66, 0x25, 0x0e,
+		0x3f, 0x8a, 0xc4, 0x9d, 0x58, 0xbc, 0xd7, 0xca, 0xf4, 0xc5, 0x63, 0xc6,
+		0xa6, 0x0a, 0xf9, 0xf2, 0x91, 0x3d, 0x35, 0x54, 0xed, 0xc6, 0xd5, 0x13,
+		0x91, 0xb5, 0x2c, 0xba, 0x4f, 0x46, 0xd7, 0x8e, 0x9e, 0xad, 0xc5, 0x7b,
+		0x0b, 0x63, 0xbf, 0x09, 0x65, 0x0b, 0xaa, 0x07, 0x91, 0xf6, 0x80, 0xc7,
+		0x99, 0x99, 0x99, 0x41, 0xe1, 0xa2, 0x91, 0x6e, 0x34, 0xd1, 0x76, 0x4d,
+		0x21, 0x88, 0x6d, 0x9d, 0x69, 0xb5, 0x77, 0xb8, 0xd7, 0xf2, 0x2d, 0x99,
+		0x61, 0xb1, 0x39, 0x6f, 0x3a, 0x60, 0x17, 0x2c, 0xef, 0x37, 0x0d, 0xa5,
+		0x56, 0xfe, 0x09, 0x09, 0x0a, 0x72, 0x70, 0x68, 0x32, 0x61, 0x7c, 0xde,
+		0x2c, 0xb9, 0x3b, 0x85, 0x97, 0x66, 0x0f, 0x33, 0x1e, 0xc3, 0xe5, 0x5c,
+		0x48, 0x85, 0xd6, 0xc7, 0x8b, 0xe1, 0x8b, 0x04, 0x21, 0x35, 0xcc, 0x62,
+		0x5d, 0x4c, 0x94, 0x38, 0x65, 0x93, 0xfd, 0x3e, 0xf5, 0x48, 0x37, 0xd1,
+		0x61, 0x75, 0xd6, 0xd0, 0x47, 0x3d, 0xda, 0xaa, 0x9a, 0xc4, 0x35, 0x1d,
+		0x47, 0xf2, 0x5d, 0x59, 0x86, 0xb6, 0x33, 0xde, 0x9b, 0x04, 0xb9, 0xbd,
+		0x4d, 0xaa, 0xcb, 0x72, 0x8f, 0x0a, 0x8d, 0x34, 0x94, 0x3e, 0xac, 0x04,
+		0x14, 0xb6, 0x3f, 0xb7, 0xb5, 0xeb, 0x1e, 0xbc, 0x78, 0x01, 0x59, 0x7f,
+		0xb7, 0xa8, 0xd9, 0x00, 0x26, 0x50, 0xba, 0x45, 0xab, 0x95, 0x3b, 0x82,
+		0x21, 0x2b, 0xbe, 0xbe, 0xb5, 0xbd, 0xe7, 0x5d, 0x2c, 0x34, 0xaa, 0x19,
+		0x0f, 0x31, 0xcb, 0xbb, 0x11, 0xec, 0x03, 0x5f, 0x3f, 0x29, 0x7e, 0xad,
+		0xf8, 0xfa, 0x7b, 0xa1, 0x57, 0x0d, 0xa8, 0x7a, 0x8d, 0xe6, 0xfb, 0xcf,
+		0xe6, 0x1b, 0x0e, 0xdd, 0x1c, 0xd2, 0xaf, 0xf8, 0xfa, 0xc0, 0x84, 0xbe,
+		0x73, 0xd8, 0x43, 0xbf, 0x6d, 0x4b, 0xd6, 0x44, 0x88, 0x61, 0xe5, 0x94,
+		0x8f, 0x8c, 0xe8, 0x0f, 0xc3, 0xc3, 0xfe, 0xa0, 0xfe, 0xfb, 0x40, 0xc6,
+		0xc5, 0x77, 0x07, 0x8c, 0xd6, 0x20, 0xe5, 0xc7, 0x44, 0x8c, 0xbc, 0x42,
+		0x0c, 0xaf, 0xe2, 0xef, 0xe0, 0xc8, 0xfe, 0x64, 0xb9, 0x73, 0xaf, 0x3b,
+		0x1d, 0x2f, 0x71, 0x37, 0x00, 0x9a, 0xcc, 0xd8, 0x84, 0xdc, 0x76, 0x8d,
+		0xc6, 0xe8, 0xfe, 0x9b, 0x86, 0xa3, 0x47, 0xbd, 0xe3, 0xc0, 0x00, 0xff,
+		0xb4, 0x11, 0x3e, 0x63, 0xdd, 0x7c, 0xd7, 0x06, 0x99, 0x3d, 0x12, 0x67,
+		0x91, 0x62, 0x5b, 0x68, 0xda, 0x03, 0xc0, 0x72, 0xaf, 0xcc, 0xbb, 0xcb,
+		0x32, 0xe7, 0xee, 0x43, 0xc8, 0xd1, 0xc4, 0x57, 0x66, 0xda, 0xee, 0x3c,
+		0x7b, 0x59, 0xbc, 0x7e, 0xe2, 0x6c, 0xeb, 0x1a, 0xec, 0x81, 0x1b, 0xef,
+		0xd1, 0xb1, 0xbf, 0x83, 0x5f, 0xb6, 0xde, 0x76, 0xe1, 0x10, 0x06, 0xe6,
+		0xbf, 0xd8, 0x58, 0x71, 0x49, 0x95, 0x53, 0xd7, 0x68, 0x7b, 0x6c, 0x9f,
+		0xd5, 0x4f, 0xfc, 0xde, 0x3f, 0x9b, 0xf6, 0x9b, 0x68, 0xec, 0xcf, 0xbf,
+		0xab, 0xeb, 0x06, 0x77, 0xab, 0x34, 0x1d, 0x52, 0xb8, 0x0f, 0xa8, 0x3b,
+		0x18, 0x38, 0x3a, 0xcd, 0x29, 0x67, 0xb3, 0x7a, 0xe8, 0x9c, 0x98, 0xd7,
+		0xe6, 0xe5, 0x1d, 0xd6, 0xdf, 0xcb, 0x0b, 0x4e, 0x1c, 0x32, 0x68, 0xd0,
+		0x9c, 0x31, 0x4c, 0x4f, 0x4a, 0x0c, 0x7b, 0x05, 0x41, 0x45, 0x7b, 0xdb,
+		0x8b, 0x70, 0x3b, 0x74, 0xf6, 0xa6, 0xb1, 0xc5, 0x65, 0x7a, 0xa7, 0xcd,
+		0x34, 0x3e, 0xb8, 0x1e, 0xfc, 0x1b, 0xc2, 0xb6, 0x65, 0xab, 0x7e, 0xf7,
+		0x19, 0x87, 0xac, 0xf9, 0xd7, 0x59, 0xe5, 0x06, 0x1f, 0x74, 0x71, 0x13,
+		0x70, 0x82, 0x69, 0xf6, 0xca, 0xda, 0x7d, 0xb3, 0x7c, 0x3b, 0x65, 0x67,
+		0x9e, 0x7e, 0x17, 0x01, 0x27, 0x5e, 0x30, 0x77, 0x68, 0xb8, 0xee, 0xfe,
+		0xf5, 0xc0, 0xc8, 0xdb, 0x9e, 0x52, 0x4c, 0xf3, 0xdb, 0xf9, 0xcc, 0xf6,
+		0x53, 0xce, 0x58, 0xb5, 0x54, 0xd1, 0x9d, 0x33, 0xec, 0x6a, 0xb7, 0xf6,
+		0x18, 0xa9, 0x47, 0x07, 0x68, 0x15, 0xa5, 0x66, 0x4a, 0xe9, 0x9b, 0x50,
+		0x1a, 0x53, 0xf7, 0xfa, 0x36, 0x33, 0x35, 0x28, 0x0d, 0xe9, 0x4f, 0x47,
+		0xb0, 0x7d, 0x6c, 0xf7, 0x41, 0x26, 0x09, 0x1e, 0xbb, 0x27, 0x5c, 0x64,
+		0x14, 0x7a, 0xc3, 0x96, 0x74, 0x66, 0xb3, 0x3e, 0xab, 0xee, 0xc2, 0x6b,
+		0xaa, 0x77, 0x24, 0x36, 0x22, 0x8d, 0xe7, 0x02, 0xa3, 0x22, 0x3a, 0x44,
+		0x15, 0x8e, 0x36, 0xb1, 0x07, 0xbf, 0x71, 0x95, 0xe2, 0xe7, 0x58, 0x68,
+		0xbf, 0x79, 0xb3, 0x3e, 0x82, 0x7f, 0xbc, 0xa4, 0xeb, 0xa8, 0x69, 0xac,
+		0xd3, 0xf8, 0x8f, 0xb2, 0x10, 0xac, 0x5b, 0xf7, 0x00, 0xb1, 0xcb, 0x13,
+		0x68, 0x39, 0x9f, 0x39, 0x3c, 0xa8, 0x3f, 0x78, 0x51, 0x36, 0x68, 0x2a,
+		0xa1, 0x9c, 0x33, 0x77, 0x29, 0x48, 0x0c, 0xf7, 0x3d, 0xe4, 0xff, 0x03,
+		0x00, 0x80, 0x43, 0x9b, 0xfd, 0x1c, 0x2a, 0x00, 0x00,
 	}))
 
 	if err != nil {
@@ -11,6 +11,7 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/benbjohnson/megajson/generator/model"
 	"github.com/benbjohnson/megajson/generator/test"
 	"github.com/stretchr/testify/assert"
 )
@@ -24,37 +25,178 @@ type Foo struct {
     Age int
 }
 `
-	f, _ := parser.ParseFile(token.NewFileSet(), "foo.go", src, 0)
-	err := NewGenerator().Generate(bytes.NewBufferString(src), f)
+	fset := token.NewFileSet()
+	f, _ := parser.ParseFile(fset, "foo.go", src, parser.ParseComments)
+	err := NewGenerator(Options{}).Generate(bytes.NewBufferString(src), model.NewPackage(fset, []*ast.File{f}).NewFile(f))
 	assert.NoError(t, err)
 }
 
 // Ensures that a simple struct can be decoded from JSON.
 func TestGenerateSimple(t *testing.T) {
-	out, err := execute("simple")
+	out, err := execute("simple", Options{})
 	assert.NoError(t, err)
 	assert.Equal(t, out, `|foo|200|189273|2392|172389984|182.23|19380.1312|true|`)
 }
 
 // Ensures that a complex nested struct can be decoded from JSON.
 func TestGenerateDecodeNested(t *testing.T) {
-	out, err := execute("nested")
+	out, err := execute("nested", Options{})
 	assert.NoError(t, err)
 	assert.Equal(t, out, `|foo|John|20|<nil>|2|Jane|60|Jack|-13|`)
 }
 
+// Ensures that named types are decoded from their underlying types.
+func TestGenerateDecodeNamed(t *testing.T) {
+	out, err := execute("named", Options{})
+	assert.NoError(t, err)
+	assert.Equal(t, out, `|100|John|2s|1|200|Jane|`)
+}
+
+// Ensures that maps can be decoded from JSON.
+func TestGenerateDecodeMaps(t *testing.T) {
+	out, err := execute("maps", Options{})
+	assert.NoError(t, err)
+	assert.Equal(t, out, `|bar|bat|-20|John|<nil>|Jane|1.5|true|bar|true|`)
+}
+
+// Ensures that the string tag option is honored.
+func TestGenerateDecodeTags(t *testing.T) {
+	out, err := execute("tags", Options{})
+	assert.NoError(t, err)
+	assert.Equal(t, out, `|x|foo|2|1000|true|bar|5|<nil>|x|baz|`+
+		`Cannot read string "5 6" into int64 at line 1, column 7 in .id|`+
+		`Cannot read string "5 6" into int at line 1, column 6 in .n|`+
+		`Cannot read string "true}" into bool at line 1, column 7 in .ok|`+
+		`Cannot read string "x" into int64 at line 1, column 7 in .id|`)
+}
+
+// Ensures that embedded struct fields are promoted like encoding/json.
+func TestGenerateDecodeEmbedded(t *testing.T) {
+	out, err := execute("embedded", Options{})
+	assert.NoError(t, err)
+	assert.Equal(t, out, `|1|foo||10|audit|||`)
+}
+
+// Ensures that every name in a multi-name field declaration is decoded.
+func TestGenerateDecodeMultiName(t *testing.T) {
+	out, err := execute("multiname", Options{})
+	assert.NoError(t, err)
+	assert.Equal(t, out, `|1.5|-2|3.25|foo|bar|`)
+}
+
+// Ensures that the generated decoder is used by json.Unmarshal.
+func TestGenerateDecodeUnmarshaler(t *testing.T) {
+	out, err := execute("marshaler", Options{Unmarshaler: true})
+	assert.NoError(t, err)
+	assert.Equal(t, out, `|foo|1|3|`)
+}
+
+// Ensures that other types are decoded using their unmarshal methods or reflection.
+func TestGenerateDecodeInterop(t *testing.T) {
+	out, err := execute("interop", Options{})
+	assert.NoError(t, err)
+	assert.Equal(t, out, `|1398947400|1398947400|<nil>|127.0.0.1|12345678901234|1|0|[3 4]|map[foo:[1 bar]]|`)
+}
+
+// Ensures that local types with marshaling methods are decoded with them.
+func TestGenerateDecodeCustomMarshaler(t *testing.T) {
+	out, err := execute("custom", Options{})
+	assert.NoError(t, err)
+	assert.Equal(t, out, `|1|2|<nil>|3|4|5|<nil>|foo|`)
+}
+
+// Ensures that nil slices of struct pointers and null arrays are decoded like
+// encoding/json.
+func TestGenerateDecodeNulls(t *testing.T) {
+	out, err := execute("nulls", Options{})
+	assert.NoError(t, err)
+	assert.Equal(t, out, `|true|true|[0 0]|[1 2]|[[0 0] [5 0]]|[1 2]|[[0 0] [5 0]]|`)
+}
+
+// Ensures that integers of every width can be decoded and overflows are detected.
+func TestGenerateDecodeWidths(t *testing.T) {
+	out, err := execute("widths", Options{})
+	assert.NoError(t, err)
+	assert.Equal(t, out, `|-128|-32768|-2147483648|255|65535|4294967295|1234|97|233|127|300|map[-1:1]|Cannot read number 128 into int8 at line 1, column 7 in .I8|Cannot read number -1 into uint16 at line 1, column 8 in .U16|Cannot read number 1.5 into int8 at line 1, column 10 in .Small|`)
+}
+
+// Ensures that slices and arrays can be decoded.
+func TestGenerateDecodeSlices(t *testing.T) {
+	out, err := execute("slices", Options{})
+	assert.NoError(t, err)
+	assert.Equal(t, out, `|[foo bar]|[1 -2 3]|true|true|[{x} {y}]|{z}|<nil>|[1.5 2 7 -4]|[[1 2] [] []]|true|[100 200]|[[true false] [false false]]|[map[a:1]]|`)
+}
+
+// Ensures that byte slices are decoded from base64 strings.
+func TestGenerateDecodeBytes(t *testing.T) {
+	out, err := execute("bytes", Options{})
+	assert.NoError(t, err)
+	assert.Equal(t, out, `|hello world|true|true|[0 1 254 255]|[1 2 3]|["foo" ""]|bar|`)
+}
+
+// Ensures that struct values are decoded in place with their generated decoders.
+func TestGenerateDecodeValues(t *testing.T) {
+	out, err := execute("values", Options{})
+	assert.NoError(t, err)
+	assert.Equal(t, out, `|foo|{Main St 12345}|{Elm St 1}|{2}|[{1 2} {3 4}]|map[a:{5 6}]|`)
+}
+
+// Ensures that interface values are decoded into the same types as encoding/json.
+func TestGenerateDecodeDynamic(t *testing.T) {
+	out, err := execute("dynamic", Options{})
+	assert.NoError(t, err)
+	assert.Equal(t, out, `|map[string]interface {}{"a":[]interface {}{1, "x", interface {}(nil)}}|1.5|<nil>|map[string]interface {}{"ID":1}|[]interface {}{true, "s", 3, []interface {}{}}|"named"|`)
+}
+
+// Ensures that only types selected by directives are decoded.
+func TestGenerateDecodeDirectives(t *testing.T) {
+	out, err := execute("directives", Options{})
+	assert.NoError(t, err)
+	assert.Equal(t, out, `|foo|{1}|2|4|`)
+}
+
+// Ensures that keys which differ in case are matched like encoding/json.
+func TestGenerateDecodeCaseInsensitive(t *testing.T) {
+	out, err := execute("fold", Options{CaseInsensitive: true})
+	assert.NoError(t, err)
+	assert.Equal(t, out, `|foo|x|folded|exact|`)
+}
+
+// Ensures that unknown fields are skipped unless the decoder is strict.
+func TestGenerateDecodeStrict(t *testing.T) {
+	out, err := execute("strict", Options{})
+	assert.NoError(t, err)
+	assert.Equal(t, out, `|foo|3|<nil>|Unknown field "Extra" at line 1, column 15 in .Extra|Cannot read string "x" into int at line 1, column 8 in .Age|Unexpected left brace at line 1, column 11; expected end of input|<nil>|`)
+}
+
+// Ensures that a decoder can read a byte slice in place.
+func TestGenerateDecodeInPlace(t *testing.T) {
+	out, err := execute("inplace", Options{})
+	assert.NoError(t, err)
+	assert.Equal(t, out, `foo|12|["a" "b\n"]|été|150|<nil>|bar|0|[]|0|<nil>|baz|0|[]|0|Unexpected right brace at line 1, column 15 in .Name; expected string|`)
+}
+
+// Ensures that tokens larger than the maximum size return an error.
+func TestGenerateDecodeMaxTokenSize(t *testing.T) {
+	out, err := execute("maxtoken", Options{})
+	assert.NoError(t, err)
+	assert.Equal(t, out, `foo|12345|2|<nil>|foo|0|0|Token exceeds the maximum size of 8 bytes at line 1, column 23 in .Count|foo|12|0|Token exceeds the maximum size of 8 bytes at line 1, column 38 in .Tags[1]|foo|0|0|Exceeded the maximum nesting depth of 10000 at line 1, column 10022||Token exceeds the maximum size of 8 bytes at line 1, column 9 in .Name|`)
+}
+
 // execute generates a decoder against a fixture, executes the main prorgam, and returns the results.
-func execute(name string) (ret string, err error) {
+func execute(name string, options Options) (ret string, err error) {
 	test.Test(name, func(path string) {
 		var file *ast.File
-		file, err = parser.ParseFile(token.NewFileSet(), filepath.Join(path, "types.go"), nil, 0)
+		fset := token.NewFileSet()
+		file, err = parser.ParseFile(fset, filepath.Join(path, "types.go"), nil, parser.ParseComments)
 		if err != nil {
 			return
 		}
+		pkg := model.NewPackage(fset, []*ast.File{file})
 
 		// Generate decoder.
 		f, _ := os.Create(filepath.Join(path, "decoder.go"))
-		if err = NewGenerator().Generate(f, file); err != nil {
+		if err = NewGenerator(options).Generate(f, pkg.NewFile(file)); err != nil {
 			fmt.Println("generate error:", err.Error())
 			return
 		}
@@ -0,0 +1,144 @@
+package generator
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+// context is the number of unchanged lines shown around each change.
+const context = 3
+
+// maxdiff is the largest number of line pairs compared when computing a
+// diff. Larger changes are shown as a replacement of every changed line.
+const maxdiff = 4 << 20
+
+// edit is a single line of a diff.
+type edit struct {
+	op   byte // ' ', '-' or '+'
+	line string
+}
+
+// diff returns a unified diff from the old to the new contents of a file.
+// Returns an empty string if the contents are equal.
+func diff(path string, a, b []byte) string {
+	if bytes.Equal(a, b) {
+		return ""
+	}
+	edits := edits(lines(a), lines(b))
+
+	// Track the line numbers that each edit starts at.
+	x, y := make([]int, len(edits)+1), make([]int, len(edits)+1)
+	for i, e := range edits {
+		x[i+1], y[i+1] = x[i], y[i]
+		if e.op != '+' {
+			x[i+1]++
+		}
+		if e.op != '-' {
+			y[i+1]++
+		}
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "--- %s.orig\n+++ %s\n", path, path)
+	for i := 0; i < len(edits); i++ {
+		if edits[i].op == ' ' {
+			continue
+		}
+
+		// Extend the hunk while changes are close enough to share context.
+		start, end := max(0, i-context), i+1
+		for j := i + 1; j < len(edits) && j <= end+2*context; j++ {
+			if edits[j].op != ' ' {
+				end = j + 1
+			}
+		}
+		end = min(len(edits), end+context)
+
+		fmt.Fprintf(&buf, "@@ -%s +%s @@\n", hunkrange(x[start], x[end]), hunkrange(y[start], y[end]))
+		for _, e := range edits[start:end] {
+			buf.WriteByte(e.op)
+			buf.WriteString(e.line)
+			if !strings.HasSuffix(e.line, "\n") {
+				buf.WriteString("\n\\ No newline at end of file\n")
+			}
+		}
+		i = end - 1
+	}
+	return buf.String()
+}
+
+// hunkrange formats the lines from start to end for a hunk header.
+func hunkrange(start, end int) string {
+	if start == end {
+		return fmt.Sprintf("%d,0", start)
+	} else if end-start == 1 {
+		return fmt.Sprintf("%d", start+1)
+	}
+	return fmt.Sprintf("%d,%d", start+1, end-start)
+}
+
+// lines splits text into lines which keep their line endings.
+func lines(b []byte) []string {
+	a := strings.SplitAfter(string(b), "\n")
+	if a[len(a)-1] == "" {
+		a = a[:len(a)-1]
+	}
+	return a
+}
+
+// edits returns the edits which change the lines of a into the lines of b
+// using the longest common subsequence of lines that differ.
+func edits(a, b []string) []edit {
+	var prefix, suffix []edit
+	for len(a) > 0 && len(b) > 0 && a[0] == b[0] {
+		prefix = append(prefix, edit{' ', a[0]})
+		a, b = a[1:], b[1:]
+	}
+	for len(a) > 0 && len(b) > 0 && a[len(a)-1] == b[len(b)-1] {
+		suffix = append([]edit{{' ', a[len(a)-1]}}, suffix...)
+		a, b = a[:len(a)-1], b[:len(b)-1]
+	}
+
+	var middle []edit
+	if len(a)*len(b) > maxdiff {
+		for _, line := range a {
+			middle = append(middle, edit{'-', line})
+		}
+		for _, line := range b {
+			middle = append(middle, edit{'+', line})
+		}
+	} else {
+		// lcs[i][j] is the length of the common subsequence of a[i:] and b[j:].
+		lcs := make([][]int, len(a)+1)
+		for i := range lcs {
+			lcs[i] = make([]int, len(b)+1)
+		}
+		for i := len(a) - 1; i >= 0; i-- {
+			for j := len(b) - 1; j >= 0; j-- {
+				if a[i] == b[j] {
+					lcs[i][j] = lcs[i+1][j+1] + 1
+				} else {
+					lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+				}
+			}
+		}
+
+		i, j := 0, 0
+		for i < len(a) || j < len(b) {
+			switch {
+			case i < len(a) && j < len(b) && a[i] == b[j]:
+				middle = append(middle, edit{' ', a[i]})
+				i, j = i+1, j+1
+			case j == len(b) || (i < len(a) && lcs[i+1][j] >= lcs[i][j+1]):
+				middle = append(middle, edit{'-', a[i]})
+				i++
+			default:
+				middle = append(middle, edit{'+', b[j]})
+				j++
+			}
+		}
+	}
+
+	return append(append(prefix, middle...), suffix...)
+}
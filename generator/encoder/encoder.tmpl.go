@@ -9,60 +9,140 @@ import (
 // tmplsrc returns raw, uncompressed file data.
 func tmplsrc() []byte {
 	gz, err := gzip.NewReader(bytes.NewBuffer([]byte{
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x09, 0x6e, 0x88, 0x00, 0xff, 0xac, 0x96,
-		0xcf, 0x6f, 0x9b, 0x30, 0x14, 0xc7, 0xcf, 0xf8, 0xaf, 0x78, 0x43, 0xdd,
-		0x0a, 0x55, 0x45, 0xa6, 0xad, 0xea, 0xa1, 0x53, 0x77, 0xa8, 0xb4, 0x48,
-		0xdb, 0x21, 0x93, 0x56, 0x55, 0x3b, 0x54, 0x3d, 0x10, 0x62, 0x12, 0xb7,
-		0xc4, 0xce, 0xc0, 0xc0, 0x22, 0x8f, 0xff, 0x7d, 0xfe, 0x01, 0x49, 0xf8,
-		0xdd, 0x4d, 0x5c, 0x82, 0xb1, 0x9f, 0xdf, 0xe7, 0xfb, 0xbe, 0xc0, 0x73,
-		0x76, 0x7e, 0xf0, 0xe2, 0xaf, 0x31, 0x08, 0xe1, 0x2d, 0xfc, 0x2d, 0xd6,
-		0x3f, 0x45, 0x81, 0x10, 0xd9, 0xee, 0x58, 0xcc, 0xc1, 0x41, 0x96, 0x4d,
-		0x98, 0x2d, 0x7f, 0xd7, 0x84, 0x6f, 0xd2, 0xa5, 0x17, 0xb0, 0xed, 0x6c,
-		0x89, 0xe9, 0xf2, 0x99, 0x6d, 0x68, 0xc2, 0xe8, 0x6c, 0x8b, 0xd7, 0xfe,
-		0xb3, 0x1a, 0xe4, 0x31, 0xe1, 0x38, 0xb6, 0x91, 0x8b, 0x90, 0x10, 0xb1,
-		0x4f, 0x65, 0x4e, 0xbe, 0xdf, 0xe1, 0x04, 0x3c, 0x99, 0x4e, 0x8d, 0x1a,
-		0x88, 0x6f, 0xf7, 0xdf, 0x17, 0x5f, 0x68, 0xc0, 0x56, 0x38, 0x86, 0x84,
-		0xc7, 0x69, 0xc0, 0x41, 0x20, 0x2b, 0x87, 0x0b, 0x93, 0xc8, 0xfb, 0xa9,
-		0x2f, 0x48, 0x4a, 0x09, 0x53, 0x1a, 0xc0, 0x02, 0xe7, 0xbd, 0xfb, 0x9d,
-		0x1c, 0x08, 0x2b, 0x37, 0xb8, 0x70, 0xd1, 0xcf, 0x91, 0x80, 0x18, 0xf3,
-		0x34, 0xa6, 0xf0, 0xae, 0x37, 0x48, 0xe4, 0x37, 0x50, 0x4a, 0x90, 0x4c,
-		0x93, 0xd4, 0xc9, 0xdd, 0x62, 0x58, 0xc9, 0x0f, 0x3f, 0x3f, 0x8a, 0xa9,
-		0x97, 0x30, 0x8d, 0xa2, 0x23, 0xdf, 0xc1, 0x03, 0x09, 0x5d, 0x30, 0x03,
-		0x27, 0x6b, 0x06, 0xb9, 0x80, 0xe3, 0x98, 0x69, 0x22, 0x09, 0xd5, 0x18,
-		0x6e, 0x6e, 0x01, 0x7b, 0x07, 0xe1, 0x4e, 0xe6, 0x7e, 0xd2, 0xd3, 0x6f,
-		0x6e, 0x81, 0x92, 0x48, 0xc5, 0x55, 0xd2, 0xe4, 0x2c, 0xb2, 0x8a, 0xfa,
-		0xbe, 0xdc, 0x9b, 0x47, 0x69, 0xb2, 0x71, 0x46, 0x37, 0x95, 0xb7, 0x72,
-		0xf5, 0xb5, 0x15, 0x9c, 0x48, 0x1a, 0x2e, 0x22, 0x83, 0xdb, 0x36, 0x56,
-		0x2a, 0xd3, 0xbe, 0x2f, 0xd2, 0x28, 0x72, 0x5c, 0x25, 0xa1, 0x29, 0x5c,
-		0x2f, 0xdf, 0xed, 0x39, 0x76, 0xce, 0xc5, 0xf9, 0x98, 0x7e, 0x64, 0x55,
-		0x6f, 0xf3, 0x19, 0xa1, 0x2b, 0xfc, 0xfb, 0x12, 0xce, 0x42, 0x82, 0xa3,
-		0x95, 0x4a, 0xa6, 0x07, 0xe6, 0x05, 0xb7, 0x64, 0x98, 0xa4, 0x98, 0x18,
-		0x7d, 0xdf, 0x0f, 0xbd, 0xec, 0x80, 0xd6, 0xb1, 0x96, 0x65, 0x32, 0x62,
-		0xba, 0x52, 0xdf, 0xa2, 0x65, 0xcd, 0x66, 0xa0, 0x13, 0xc0, 0x0b, 0xde,
-		0x83, 0x4f, 0x57, 0x10, 0xb0, 0x88, 0x51, 0x0f, 0x75, 0x52, 0xee, 0x79,
-		0x4c, 0xe8, 0xda, 0x11, 0x42, 0x06, 0x53, 0x69, 0x1b, 0x78, 0xf0, 0x07,
-		0x76, 0x72, 0x8e, 0x87, 0x60, 0xbf, 0xfd, 0x65, 0x4b, 0x1b, 0xdb, 0xfc,
-		0x1a, 0xbe, 0xe8, 0x4e, 0x6c, 0xe4, 0xdf, 0x74, 0xc9, 0x6f, 0x6c, 0x3f,
-		0x95, 0x9c, 0xf9, 0x51, 0x8a, 0x95, 0x54, 0x1d, 0x98, 0xa9, 0x8c, 0x99,
-		0x27, 0x84, 0x36, 0xcf, 0xc8, 0x33, 0x35, 0x1a, 0x07, 0x49, 0x22, 0x95,
-		0x6e, 0x09, 0x27, 0x19, 0xd6, 0x4d, 0xc3, 0x98, 0x7b, 0x58, 0x34, 0x73,
-		0x60, 0x27, 0xba, 0x46, 0xbb, 0x5c, 0x1c, 0x70, 0xa1, 0xe3, 0xad, 0x6e,
-		0xbb, 0x6d, 0x2a, 0x3e, 0x3a, 0xde, 0x01, 0x94, 0xee, 0x0d, 0xd1, 0xbe,
-		0x52, 0x3e, 0x25, 0xea, 0xfa, 0x6a, 0x04, 0x76, 0x7d, 0x35, 0x19, 0x2e,
-		0x1d, 0x29, 0xed, 0x81, 0x4c, 0x58, 0x5b, 0x3a, 0x5a, 0xdc, 0x03, 0x99,
-		0xb4, 0xba, 0x30, 0x62, 0x3e, 0xff, 0xf8, 0x61, 0x88, 0x38, 0x37, 0x21,
-		0xd3, 0x22, 0x87, 0x8b, 0x9c, 0x9b, 0x90, 0xc9, 0x90, 0x4b, 0xc6, 0xa2,
-		0x21, 0xde, 0x9d, 0x5c, 0xff, 0x6f, 0x58, 0x6d, 0x58, 0xc3, 0x5e, 0x54,
-		0xcc, 0x23, 0x52, 0x9f, 0x8e, 0x49, 0xba, 0xac, 0x3e, 0xde, 0xc6, 0xe1,
-		0x28, 0x15, 0xb9, 0xc3, 0x67, 0x4e, 0x5b, 0xd1, 0x88, 0x88, 0xc7, 0xa7,
-		0xb6, 0x8a, 0x46, 0xcb, 0x7a, 0xec, 0xec, 0xb8, 0x6d, 0x8e, 0xbe, 0x84,
-		0xf2, 0x7c, 0x29, 0x5b, 0xbd, 0x6e, 0x56, 0xa6, 0xfb, 0x67, 0xd5, 0x26,
-		0xc5, 0x56, 0xab, 0xf0, 0x19, 0xde, 0x1f, 0x3c, 0xfc, 0xc7, 0x5e, 0xdf,
-		0xe1, 0x7a, 0x69, 0xbb, 0xd5, 0x7a, 0x86, 0x53, 0x18, 0xda, 0xf3, 0x8c,
-		0xcb, 0x82, 0x7b, 0xc5, 0x3f, 0xbd, 0xd2, 0xb6, 0xda, 0xe3, 0x29, 0xd0,
-		0xc9, 0xc1, 0xd5, 0x9b, 0xba, 0x18, 0x3d, 0x78, 0xeb, 0x7f, 0x1c, 0xaa,
-		0x94, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xbe, 0x5e, 0x00, 0x61, 0xaf,
-		0x0a, 0x00, 0x00,
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59,
+		0xdd, 0x73, 0x9b, 0x46, 0x10, 0x7f, 0xe6, 0xfe, 0x8a, 0x2d, 0xe3, 0xda,
+		0xe0, 0x28, 0x28, 0xcf, 0x6e, 0xdd, 0x99, 0xa6, 0x71, 0xa6, 0x69, 0x26,
+		0x76, 0x1b, 0x7b, 0xa6, 0x0f, 0x1e, 0x3d, 0x80, 0x58, 0xe4, 0xb3, 0xe0,
+		0x50, 0xe0, 0x80, 0x68, 0x2e, 0xfc, 0xef, 0x9d, 0xbb, 0x03, 0xf1, 0x21,
+		0xb0, 0xa4, 0xda, 0x4a, 0xfc, 0x20, 0xe0, 0x3e, 0x76, 0x7f, 0xfb, 0xbd,
+		0x77, 0x9e, 0x4e, 0xe1, 0x8f, 0xd8, 0x47, 0x58, 0x20, 0xc3, 0xc4, 0xe5,
+		0xe8, 0x83, 0xb7, 0x86, 0x08, 0x17, 0xee, 0x63, 0x1a, 0x33, 0x07, 0xde,
+		0xdd, 0xc0, 0xf5, 0xcd, 0x1d, 0x5c, 0xbd, 0xfb, 0x70, 0xe7, 0x10, 0xb2,
+		0x72, 0xe7, 0x4b, 0x77, 0x81, 0x20, 0x84, 0x73, 0xed, 0x46, 0x58, 0x96,
+		0x84, 0xd0, 0x68, 0x15, 0x27, 0x1c, 0x2c, 0x62, 0x98, 0xde, 0x9a, 0x63,
+		0x6a, 0x12, 0xc3, 0x44, 0x36, 0x8f, 0x7d, 0xca, 0x16, 0x53, 0x49, 0x43,
+		0x0e, 0xd0, 0x58, 0xfe, 0xa6, 0x71, 0xc2, 0xd5, 0x93, 0x27, 0xf3, 0x98,
+		0xe5, 0xf2, 0x75, 0x41, 0xf9, 0x43, 0xe6, 0x39, 0xf3, 0x38, 0x9a, 0x7a,
+		0xc8, 0xbc, 0xc7, 0xf8, 0x81, 0xa5, 0x31, 0x9b, 0xd6, 0xfc, 0xa7, 0x45,
+		0x42, 0x39, 0x26, 0x26, 0x31, 0x84, 0x78, 0x0d, 0x89, 0xcb, 0x16, 0x08,
+		0xce, 0x07, 0xc5, 0x31, 0x2d, 0x4b, 0x62, 0x6c, 0x70, 0x48, 0x44, 0x7f,
+		0xbb, 0xfc, 0x01, 0xbe, 0xc1, 0x2a, 0xa1, 0x8c, 0x07, 0x60, 0xfe, 0xfc,
+		0xc5, 0xd4, 0x4b, 0x5e, 0x03, 0x32, 0xbf, 0x2c, 0x89, 0x4d, 0x88, 0x10,
+		0x15, 0x8d, 0x2b, 0x89, 0x10, 0x13, 0x49, 0x84, 0xaf, 0x57, 0x2d, 0x81,
+		0xfe, 0xba, 0xbd, 0xb9, 0xae, 0x26, 0x21, 0xe5, 0x49, 0x36, 0xe7, 0x20,
+		0x88, 0x51, 0xc0, 0xb9, 0x46, 0xe2, 0xfc, 0xab, 0x1e, 0xa4, 0x24, 0x24,
+		0xc8, 0xd8, 0x1c, 0xae, 0xb1, 0x18, 0xda, 0x6a, 0x15, 0x40, 0xe3, 0x6a,
+		0xad, 0x0d, 0xe7, 0x83, 0xd4, 0x85, 0xc6, 0x46, 0x03, 0x90, 0x7a, 0x59,
+		0xe2, 0x5a, 0x49, 0x54, 0x14, 0x70, 0x71, 0x09, 0x15, 0xb3, 0x6b, 0x2c,
+		0x34, 0x0d, 0xab, 0xb0, 0xe5, 0x94, 0x73, 0x8b, 0xfc, 0x36, 0x4e, 0xf8,
+		0x47, 0x5c, 0xa7, 0x16, 0x4f, 0x32, 0xb4, 0x89, 0x91, 0x20, 0xcf, 0x12,
+		0x06, 0xa7, 0x43, 0x3c, 0x44, 0x71, 0x01, 0x45, 0x51, 0x2b, 0x21, 0x4c,
+		0xa5, 0xc1, 0x76, 0x6f, 0xd8, 0xe6, 0xdd, 0x51, 0xe3, 0x98, 0xe8, 0x9f,
+		0xdd, 0xa2, 0x91, 0xbe, 0xab, 0xae, 0x27, 0x54, 0xb0, 0x13, 0x4d, 0xc3,
+		0xd0, 0xc2, 0x61, 0x32, 0x36, 0xe8, 0x17, 0x2b, 0x6f, 0xcd, 0xdb, 0x80,
+		0x49, 0x12, 0x2b, 0x16, 0x34, 0x90, 0xef, 0x52, 0xad, 0xe8, 0x6c, 0x40,
+		0x5a, 0xb9, 0xfd, 0x8b, 0x1a, 0xfe, 0xe9, 0x12, 0x18, 0x0d, 0xe5, 0xba,
+		0x1a, 0x0b, 0x26, 0x09, 0x31, 0xca, 0xee, 0xbe, 0xc2, 0x79, 0x1f, 0x66,
+		0xe9, 0x83, 0xb5, 0x73, 0x53, 0xf5, 0xc9, 0x68, 0xb8, 0x07, 0xee, 0x16,
+		0x9a, 0x51, 0xe8, 0x39, 0x5c, 0x6e, 0x33, 0x73, 0x0a, 0xad, 0xd9, 0xeb,
+		0x2c, 0x0c, 0x2d, 0x5b, 0x32, 0xee, 0xc3, 0x55, 0xd3, 0x6f, 0xd7, 0x1c,
+		0xad, 0x33, 0x71, 0xb6, 0x0b, 0xb5, 0x34, 0xef, 0x89, 0xbf, 0x66, 0x6e,
+		0x44, 0xe7, 0x92, 0x80, 0xf3, 0xa7, 0x9b, 0xde, 0xac, 0x38, 0x8d, 0x99,
+		0x1b, 0xbe, 0xa7, 0x18, 0xfa, 0x55, 0xb0, 0xd1, 0x00, 0xea, 0x65, 0x72,
+		0xc0, 0xa0, 0xcc, 0xc7, 0xaf, 0x72, 0xc3, 0x1b, 0x39, 0xab, 0xdd, 0x83,
+		0x18, 0x75, 0x90, 0x9d, 0xa8, 0xe9, 0x09, 0x9c, 0x04, 0x92, 0x84, 0xa2,
+		0xdb, 0x10, 0x33, 0x24, 0x0a, 0x4d, 0xd2, 0xb9, 0x8a, 0x3c, 0xf4, 0x7d,
+		0xf4, 0xd5, 0xb8, 0x94, 0xa3, 0xa1, 0x30, 0x81, 0x13, 0x54, 0x3b, 0x9b,
+		0x35, 0x1a, 0x06, 0x2d, 0x4b, 0x38, 0x3d, 0x85, 0x8a, 0x6b, 0xee, 0x34,
+		0x99, 0x40, 0x4b, 0x59, 0x4d, 0x40, 0xc5, 0x46, 0x7d, 0xc8, 0xd7, 0x5c,
+		0x52, 0x6b, 0x2d, 0x27, 0x0d, 0x8c, 0x9b, 0x88, 0xf2, 0xab, 0x68, 0xc5,
+		0xd7, 0x2d, 0x1c, 0x2c, 0x66, 0x28, 0x87, 0xc0, 0xb9, 0x93, 0x69, 0xc2,
+		0xcc, 0xcd, 0x01, 0x9a, 0x03, 0x9a, 0xd1, 0xfb, 0xb5, 0x7e, 0x7e, 0x83,
+		0x37, 0x7a, 0x8b, 0x1e, 0x1c, 0x36, 0xd2, 0x64, 0xc0, 0x48, 0xea, 0xaf,
+		0x6d, 0x29, 0xf5, 0x57, 0x92, 0xd6, 0x43, 0x08, 0x19, 0xd6, 0xa0, 0x34,
+		0x22, 0x99, 0xb5, 0x98, 0x1f, 0xc6, 0xa7, 0xcf, 0x66, 0x43, 0xbe, 0x32,
+		0xaa, 0x61, 0x18, 0xd3, 0x29, 0x28, 0x42, 0xb0, 0xc4, 0x35, 0xb8, 0xcc,
+		0x87, 0x79, 0x1c, 0xc6, 0xcc, 0x21, 0x23, 0xfc, 0x6e, 0x79, 0x42, 0xd9,
+		0xc2, 0x12, 0xc2, 0xf9, 0x88, 0xeb, 0x7e, 0x5e, 0x1e, 0x04, 0xd1, 0xc3,
+		0x50, 0x92, 0x27, 0x25, 0xb9, 0x38, 0xdb, 0x8f, 0x48, 0x17, 0x7b, 0xee,
+		0x86, 0x19, 0x3a, 0x8d, 0xd5, 0x9c, 0x7f, 0xb2, 0x98, 0xd7, 0xae, 0x27,
+		0xc7, 0x4e, 0x54, 0x3d, 0xb8, 0xb8, 0xd4, 0x16, 0x6f, 0xc6, 0xa5, 0x39,
+		0x53, 0x46, 0xc3, 0xd0, 0xf5, 0x42, 0xec, 0x4e, 0x6e, 0x76, 0x5d, 0x02,
+		0x86, 0x18, 0xf5, 0x26, 0xfb, 0x31, 0x3c, 0x66, 0x1f, 0x1d, 0xcb, 0xc3,
+		0x4e, 0xb0, 0xed, 0x05, 0xb5, 0x1b, 0x18, 0xa5, 0x4a, 0xec, 0xcd, 0x52,
+		0xe5, 0xe0, 0xe7, 0x39, 0x31, 0xda, 0xf6, 0x6b, 0xcb, 0xa0, 0x90, 0x6a,
+		0xbc, 0xb2, 0x12, 0x53, 0xb6, 0x30, 0xdb, 0x58, 0xbd, 0x49, 0x8d, 0x4c,
+		0x35, 0x00, 0x9f, 0xdc, 0x24, 0x7d, 0x70, 0x43, 0x4b, 0x08, 0x59, 0xb2,
+		0xeb, 0x7d, 0xf9, 0x98, 0x09, 0x87, 0xfc, 0x15, 0x6a, 0x17, 0x1d, 0xf1,
+		0x10, 0x0d, 0xc2, 0xf2, 0xec, 0x03, 0x23, 0x40, 0xfb, 0x7e, 0x1b, 0xfb,
+		0xb0, 0xa3, 0x98, 0x87, 0x86, 0x96, 0x21, 0x04, 0xc7, 0x68, 0x15, 0xba,
+		0x1c, 0x41, 0xf7, 0x32, 0x68, 0x6a, 0xc9, 0x8f, 0xc0, 0x6c, 0xc8, 0x44,
+		0x23, 0x6e, 0x36, 0xb0, 0xa3, 0xab, 0x83, 0x21, 0xdc, 0x6d, 0x12, 0xbb,
+		0x73, 0x96, 0xcc, 0x21, 0xaf, 0x5e, 0xf5, 0x17, 0x0f, 0xe6, 0xc7, 0x92,
+		0x0c, 0x2d, 0xea, 0xe6, 0xf2, 0xde, 0x9a, 0xb2, 0x5d, 0x26, 0x46, 0x55,
+		0x58, 0x9e, 0x1d, 0x58, 0x65, 0x15, 0xe7, 0x48, 0x3b, 0x2a, 0x26, 0x65,
+		0x59, 0x95, 0xdd, 0x1c, 0x5a, 0x25, 0xb5, 0xf2, 0x63, 0x59, 0x7e, 0x2d,
+		0x1b, 0xac, 0xfb, 0x99, 0x6c, 0x56, 0x27, 0xba, 0xd2, 0xda, 0xad, 0x46,
+		0x24, 0x77, 0x7e, 0x5f, 0xad, 0x90, 0xf9, 0x6a, 0x21, 0xa3, 0xa1, 0xdd,
+		0x94, 0xf1, 0x0e, 0xbd, 0xd6, 0x2a, 0x0f, 0x34, 0xb5, 0x41, 0xb2, 0x5e,
+		0x16, 0x48, 0x11, 0xe5, 0x70, 0x2a, 0x7b, 0xaa, 0xb7, 0x59, 0x10, 0x60,
+		0x62, 0x79, 0x76, 0x5b, 0x01, 0x63, 0x5d, 0xa4, 0x97, 0x05, 0x76, 0xd5,
+		0xaa, 0x5a, 0xa7, 0xe3, 0x0d, 0x0b, 0xa3, 0xe1, 0xa4, 0xaf, 0x1a, 0x2f,
+		0x0b, 0x1c, 0xa9, 0xce, 0xd4, 0xb2, 0x27, 0x95, 0x9e, 0x6a, 0xd5, 0xd7,
+		0x4f, 0x22, 0x84, 0x8f, 0x01, 0x65, 0x8d, 0xb3, 0x6c, 0xaa, 0x3c, 0x4d,
+		0x57, 0x09, 0x8d, 0x28, 0xa7, 0x39, 0xaa, 0x80, 0x77, 0x74, 0xb9, 0xdf,
+		0xb6, 0x98, 0x10, 0x4b, 0xca, 0x7c, 0x70, 0xe0, 0x1b, 0x44, 0xc8, 0x1f,
+		0x62, 0x9f, 0x29, 0x21, 0xea, 0x74, 0xe1, 0x8c, 0xa6, 0x8a, 0x4e, 0x38,
+		0xb4, 0xfc, 0xa2, 0x93, 0xa5, 0x1c, 0x30, 0xcf, 0xcd, 0x1e, 0x6b, 0xa5,
+		0xab, 0x34, 0xf3, 0x6a, 0x5c, 0xbd, 0xd6, 0x13, 0x9d, 0xc2, 0x6e, 0x75,
+		0x79, 0x2f, 0x86, 0x43, 0x9f, 0x05, 0x86, 0xc1, 0xa8, 0x29, 0x29, 0xf8,
+		0x6e, 0x3c, 0xa7, 0xf9, 0x33, 0x30, 0xdc, 0xcf, 0x36, 0xfc, 0xbb, 0x15,
+		0xe5, 0x90, 0x6a, 0xd2, 0x61, 0x66, 0x94, 0x64, 0x93, 0xa0, 0xc7, 0x28,
+		0xe9, 0xa0, 0xbc, 0x3f, 0xdb, 0x87, 0x98, 0xfc, 0x0d, 0xe2, 0x04, 0xaa,
+		0xce, 0x4f, 0xd5, 0x22, 0xdd, 0xca, 0xe5, 0xd5, 0x8e, 0xa1, 0xae, 0xe8,
+		0x85, 0x9a, 0x95, 0x7e, 0xbf, 0x70, 0x98, 0xa7, 0xe4, 0x7b, 0xb7, 0x24,
+		0x5a, 0xcc, 0x51, 0xd0, 0xb3, 0xb3, 0x3d, 0xd5, 0x3e, 0x6e, 0x68, 0x7d,
+		0x90, 0x3e, 0xa2, 0xad, 0x47, 0xc0, 0xa7, 0x2f, 0x18, 0x2f, 0x21, 0x9d,
+		0xeb, 0x84, 0x52, 0xcf, 0xa8, 0x11, 0x69, 0x87, 0x23, 0xb9, 0xaf, 0x06,
+		0xf2, 0xe2, 0x5e, 0xfc, 0x03, 0x5c, 0xb8, 0x56, 0x98, 0xbe, 0x7e, 0xb0,
+		0x74, 0x4f, 0x69, 0x6f, 0xb7, 0xf6, 0xca, 0xc1, 0xf5, 0x2c, 0x7c, 0x83,
+		0x26, 0x0d, 0xed, 0x4e, 0x42, 0xf7, 0x4a, 0x80, 0xd9, 0x81, 0x47, 0x81,
+		0x56, 0xa7, 0xa1, 0x8f, 0x51, 0x15, 0x99, 0xf1, 0xe6, 0xa3, 0x87, 0xbd,
+		0xd5, 0x2d, 0x1c, 0x3f, 0x88, 0x22, 0x77, 0x75, 0xaf, 0x5b, 0xcb, 0x19,
+		0x65, 0x1c, 0x93, 0xc0, 0x9d, 0xa3, 0x28, 0xbf, 0x73, 0x54, 0x7d, 0x72,
+		0x57, 0x2f, 0x17, 0x53, 0x91, 0xbb, 0xfa, 0x71, 0x05, 0x40, 0xec, 0x1d,
+		0x3a, 0x42, 0xf4, 0xee, 0xb4, 0x0c, 0xc3, 0x90, 0xaf, 0x92, 0x68, 0xe4,
+		0x2e, 0xd1, 0xba, 0x9f, 0x09, 0x21, 0xcf, 0x90, 0xd2, 0x6b, 0xa5, 0x6c,
+		0xda, 0x67, 0x27, 0xf0, 0x66, 0x02, 0x21, 0x32, 0x2b, 0xb7, 0x6d, 0x62,
+		0x54, 0x21, 0xb8, 0xdc, 0x0e, 0x3f, 0x4d, 0xeb, 0x12, 0x5c, 0xd5, 0x81,
+		0x59, 0xf2, 0x6b, 0x02, 0x4b, 0xbb, 0x15, 0x3f, 0x92, 0xb7, 0x73, 0x2b,
+		0xd3, 0x4d, 0x35, 0x2b, 0xfb, 0x37, 0x8b, 0x4e, 0xe0, 0x11, 0x28, 0xe3,
+		0x36, 0x78, 0x71, 0xdc, 0x4f, 0xf0, 0x0a, 0x90, 0xf6, 0x16, 0xb0, 0x14,
+		0x36, 0x1b, 0x4c, 0x39, 0x74, 0x4f, 0x65, 0xd1, 0x85, 0x5f, 0x9f, 0x58,
+		0xf1, 0x38, 0xab, 0x0f, 0x50, 0xa5, 0x4d, 0x36, 0xc8, 0xab, 0x12, 0xd8,
+		0x12, 0x40, 0xe1, 0x16, 0x9d, 0xf8, 0x59, 0xce, 0x48, 0x3f, 0xb4, 0xda,
+		0x77, 0x2a, 0xb5, 0x12, 0x86, 0x2a, 0x69, 0xbb, 0x97, 0x3f, 0x6a, 0x46,
+		0xda, 0x79, 0xf6, 0xdf, 0x0e, 0xfc, 0x25, 0xae, 0xcd, 0x5a, 0x49, 0xe5,
+		0xf1, 0x8f, 0xf1, 0x7b, 0xe4, 0x1d, 0x1a, 0x00, 0x8b, 0x79, 0xdf, 0x2b,
+		0xc7, 0xce, 0x3b, 0x3b, 0x92, 0x53, 0xf9, 0xfc, 0xe4, 0xb4, 0xc9, 0x48,
+		0xda, 0x75, 0xd2, 0x82, 0xf2, 0xf9, 0x03, 0x54, 0x97, 0x53, 0x96, 0x5c,
+		0xa5, 0x4e, 0x0f, 0xad, 0x6b, 0xef, 0x80, 0x86, 0xaa, 0x17, 0xd7, 0xd8,
+		0xe7, 0x6e, 0xda, 0xbe, 0x55, 0xbc, 0x20, 0xdb, 0x85, 0xc1, 0xb9, 0xde,
+		0xa3, 0x14, 0xe4, 0xfb, 0x49, 0xa2, 0xd8, 0x3d, 0x9f, 0xdb, 0xe9, 0x9e,
+		0xec, 0x9a, 0xcb, 0x66, 0xc3, 0xf0, 0x31, 0x70, 0xb3, 0x90, 0x5f, 0x8c,
+		0x58, 0xe4, 0x43, 0xad, 0xc8, 0x7d, 0x45, 0x79, 0x32, 0xbf, 0x56, 0x47,
+		0xc8, 0x4e, 0xdf, 0xd2, 0x1c, 0xc6, 0x8f, 0xdd, 0xba, 0x48, 0x7e, 0xf8,
+		0x05, 0xac, 0x0a, 0x87, 0x86, 0x65, 0x83, 0xa9, 0xfe, 0x8b, 0x52, 0x96,
+		0x9d, 0xcb, 0x99, 0xdc, 0xe9, 0x9c, 0x68, 0x77, 0x15, 0x95, 0xd1, 0x02,
+		0xf5, 0xd9, 0x2d, 0x2c, 0x6f, 0xe7, 0xf6, 0xe6, 0x9a, 0x71, 0x08, 0x1f,
+		0xc7, 0xaf, 0x7c, 0x14, 0xdf, 0x1d, 0x7e, 0xe5, 0xcf, 0xc0, 0xb7, 0xc7,
+		0x1d, 0xd1, 0x10, 0xd4, 0x1e, 0x9a, 0xce, 0x55, 0x56, 0xfe, 0x1d, 0xb4,
+		0x55, 0xfb, 0xd7, 0xb0, 0xa3, 0xa9, 0xec, 0xb5, 0x29, 0xe5, 0x3f, 0x0a,
+		0x66, 0x83, 0x6f, 0xf4, 0x46, 0x40, 0x26, 0xf2, 0xe1, 0xc3, 0xf0, 0xe6,
+		0xda, 0xf0, 0xa9, 0x9b, 0xdf, 0xba, 0xef, 0x59, 0xee, 0xdd, 0xf7, 0xd4,
+		0x75, 0xf0, 0xb0, 0xfb, 0xb5, 0x3e, 0xa1, 0xff, 0x77, 0x53, 0xb1, 0x27,
+		0xca, 0x67, 0x62, 0xeb, 0xeb, 0xfc, 0xbf, 0x01, 0x00, 0x10, 0x0c, 0xb8,
+		0x1b, 0x80, 0x1d, 0x00, 0x00,
 	}))
 
 	if err != nil {
@@ -2,37 +2,50 @@ package encoder
 
 import (
 	"bytes"
-	"go/ast"
-	"go/format"
 	"io"
+	"text/template"
+
+	"github.com/benbjohnson/megajson/generator/model"
 )
 
 // Generator writes a generated JSON decoder to a writer.
 type Generator interface {
-	Generate(io.Writer, *ast.File) error
+	Generate(io.Writer, *model.File) error
+}
+
+// Options represents settings that affect the generated encoders.
+type Options struct {
+	// SortKeys writes map keys in sorted order so that output is deterministic.
+	SortKeys bool
+
+	// Marshaler adds MarshalJSON and AppendJSON methods to each type which
+	// delegate to the generated encoder.
+	Marshaler bool
 }
 
-type generator struct{
+type generator struct {
+	options Options
 }
 
 // NewGenerator creates a new Generator instance.
-func NewGenerator() Generator {
-	return &generator{}
+func NewGenerator(options Options) Generator {
+	return &generator{options: options}
 }
 
 // Generator writes the generated decoder to the writer.
-func (g *generator) Generate(w io.Writer, f *ast.File) error {
+func (g *generator) Generate(w io.Writer, f *model.File) error {
 	// Ignore files without type specs.
-	if len(types(f)) == 0 {
+	if len(f.Encoders()) == 0 {
 		return nil
 	}
 
 	// Generate code and the format the source code.
 	var buf bytes.Buffer
-	if err := tmpl.Execute(&buf, f); err != nil {
+	t := template.Must(tmpl.Clone()).Funcs(funcs(g, f))
+	if err := t.Execute(&buf, f); err != nil {
 		return err
 	}
-	b, err := format.Source(buf.Bytes())
+	b, err := model.Format(buf.Bytes())
 	if err != nil {
 		return err
 	}
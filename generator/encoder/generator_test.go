@@ -11,6 +11,7 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/benbjohnson/megajson/generator/model"
 	"github.com/benbjohnson/megajson/generator/test"
 	"github.com/stretchr/testify/assert"
 )
@@ -24,37 +25,146 @@ type Foo struct {
     Age int
 }
 `
-	f, _ := parser.ParseFile(token.NewFileSet(), "foo.go", src, 0)
-	err := NewGenerator().Generate(bytes.NewBufferString(src), f)
+	fset := token.NewFileSet()
+	f, _ := parser.ParseFile(fset, "foo.go", src, parser.ParseComments)
+	err := NewGenerator(Options{}).Generate(bytes.NewBufferString(src), model.NewPackage(fset, []*ast.File{f}).NewFile(f))
 	assert.NoError(t, err)
 }
 
 // Ensures that a simple struct can be encoded to JSON.
 func TestGenerateEncodeSimple(t *testing.T) {
-	out, err := execute("simple")
+	out, err := execute("simple", Options{})
 	assert.NoError(t, err)
 	assert.Equal(t, out, `{"StringX":"foo","IntX":200,"Int64X":189273,"myuint":2392,"Uint64X":172389984,"Float32X":182.23,"Float64X":19380.1312,"BoolX":true}`)
 }
 
 // Ensures that a nested struct can be encoded to JSON.
 func TestGenerateEncodeNested(t *testing.T) {
-	out, err := execute("nested")
+	out, err := execute("nested", Options{})
 	assert.NoError(t, err)
-	assert.Equal(t, out, `{"StringX":"foo","BX":{"Name":"John","Age":20},"BY":null,"Bn":[{"Name":"Jane","Age":60}],"Bn2":[]}`)
+	assert.Equal(t, out, `{"StringX":"foo","BX":{"Name":"John","Age":20},"BY":null,"Bn":[{"Name":"Jane","Age":60}],"Bn2":null}`)
+}
+
+// Ensures that named types are encoded as their underlying types.
+func TestGenerateEncodeNamed(t *testing.T) {
+	out, err := execute("named", Options{})
+	assert.NoError(t, err)
+	assert.Equal(t, out, `{"ID":100,"Name":"John","Timeout":2000000000,"Friends":[{"ID":200,"Name":"Jane","Timeout":0,"Friends":null}]}`)
+}
+
+// Ensures that maps can be encoded to JSON in sorted key order.
+func TestGenerateEncodeMaps(t *testing.T) {
+	out, err := execute("maps", Options{SortKeys: true})
+	assert.NoError(t, err)
+	assert.Equal(t, out, `{"Tags":{"baz":"bat","foo":"bar"},"Counts":{"x":10,"y":-20},"Bs":{"john":{"Name":"John"},"nil":null},"ByID":{"10":{"Name":"Jane"},"9":{"Name":"Jack"}},"Nested":{"a":{"1":1.5}},"Attrs":{"admin":true},"Extra":{"a":["x",{"b":2,"z":1}],"foo":"bar","m":{"c":null,"y":true}},"Any":{"a":["x"],"z":1},"Nil":null}`)
+}
+
+// Ensures that the omitempty and string tag options are honored.
+func TestGenerateEncodeTags(t *testing.T) {
+	out, err := execute("tags", Options{})
+	assert.NoError(t, err)
+	assert.Equal(t, out, `{"name":"foo","id":"1000","ok":"true","label":"\"bar\"","n":"5","niln":null,"s":"\"x\"","-":"baz"}`)
+}
+
+// Ensures that embedded struct fields are promoted like encoding/json.
+func TestGenerateEncodeEmbedded(t *testing.T) {
+	out, err := execute("embedded", Options{})
+	assert.NoError(t, err)
+	assert.Equal(t, out, `{"id":1,"created":10,"Name":"audit","name":"foo"}{"Name":"bar"}`)
+}
+
+// Ensures that every name in a multi-name field declaration is encoded.
+func TestGenerateEncodeMultiName(t *testing.T) {
+	out, err := execute("multiname", Options{})
+	assert.NoError(t, err)
+	assert.Equal(t, out, `{"X":1.5,"Y":-2,"Z":3.25,"Name":"foo","Label":"bar"}`)
+}
+
+// Ensures that the generated encoder is used by json.Marshal.
+func TestGenerateEncodeMarshaler(t *testing.T) {
+	out, err := execute("marshaler", Options{Marshaler: true})
+	assert.NoError(t, err)
+	assert.Equal(t, out, `{"Name":"foo","B":{"ID":1},"count":3}|{"Name":"foo","B":{"ID":1},"count":3}`)
+}
+
+// Ensures that other types are encoded using their marshal methods or reflection.
+func TestGenerateEncodeInterop(t *testing.T) {
+	out, err := execute("interop", Options{})
+	assert.NoError(t, err)
+	assert.Equal(t, out, `{"Time":"2014-05-01T12:30:00Z","TimePtr":"2014-05-01T12:30:00Z","NilTime":null,"IP":"127.0.0.1","Big":12345678901234,"Level":"high","Levels":{"a":"low"},"Sizes":[3,4],"Extra":["foo"]}`)
+}
+
+// Ensures that local types with marshaling methods are encoded with them.
+func TestGenerateEncodeCustomMarshaler(t *testing.T) {
+	out, err := execute("custom", Options{})
+	assert.NoError(t, err)
+	assert.Equal(t, out, `{"Value":"custom-1","Ptr":"custom-2","Nil":null,"Map":{"a":"custom-3"},"Slice":["custom-4"],"Ptrs":["custom-5",null],"Label":"FOO","Extra":"custom-6"}`)
+}
+
+// Ensures that nil slices of struct pointers and null arrays are encoded like
+// encoding/json.
+func TestGenerateEncodeNulls(t *testing.T) {
+	out, err := execute("nulls", Options{})
+	assert.NoError(t, err)
+	assert.Equal(t, out, `{"Bs":null,"Empty":[],"Array":[1,2],"Matrix":null}|{"Bs":null,"Empty":[],"Array":[1,2],"Matrix":null}`)
+}
+
+// Ensures that integers of every width can be encoded.
+func TestGenerateEncodeWidths(t *testing.T) {
+	out, err := execute("widths", Options{})
+	assert.NoError(t, err)
+	assert.Equal(t, out, `{"I8":-128,"I16":-32768,"I32":-2147483648,"U8":255,"U16":65535,"U32":4294967295,"Ptr":1234,"Byte":97,"Rune":233,"Small":127,"Quoted":"300","Keys":{"-1":1}}`)
+}
+
+// Ensures that slices and arrays can be encoded.
+func TestGenerateEncodeSlices(t *testing.T) {
+	out, err := execute("slices", Options{})
+	assert.NoError(t, err)
+	assert.Equal(t, out, `{"Strings":["foo","bar"],"Ints":[1,-2,3],"Nil":null,"Empty":[],"Values":[{"Name":"x"},{"Name":"y"}],"Ptrs":[{"Name":"z"},null],"Array":[1.5,2,0,-4],"Nested":[[1,2],null,[]],"IDs":[100,200],"Matrix":[[true,false],[false,true]],"Maps":[{"a":1}]}`)
+}
+
+// Ensures that byte slices are encoded as base64 strings.
+func TestGenerateEncodeBytes(t *testing.T) {
+	out, err := execute("bytes", Options{})
+	assert.NoError(t, err)
+	assert.Equal(t, out, `{"Data":"aGVsbG8gd29ybGQ=","Nil":null,"Empty":"","Blob":"AAH+/w==","Fixed":[1,2,3],"Chunks":["Zm9v",null]}`)
+}
+
+// Ensures that struct values are encoded with their generated encoders.
+func TestGenerateEncodeValues(t *testing.T) {
+	out, err := execute("values", Options{})
+	assert.NoError(t, err)
+	assert.Equal(t, out, `{"Name":"foo","Address":{"Street":"Main St","zip":12345},"Home":null,"inner":{"Version":2},"Points":[{"X":1,"Y":2},{"X":3,"Y":4}],"Index":{"a":{"X":5,"Y":6}}}`)
+}
+
+// Ensures that interface values are encoded by their dynamic type.
+func TestGenerateEncodeDynamic(t *testing.T) {
+	out, err := execute("dynamic", Options{})
+	assert.NoError(t, err)
+	assert.Equal(t, out, `{"Extra":{"a":[1,"x",null]},"Payload":1.5,"Nil":null,"Ptr":{"ID":1,"hidden":"h"},"Value":{"ID":2,"hidden":"i"},"List":[true,"s",3,["z"]],"Named":"named"}`)
+}
+
+// Ensures that only types selected by directives are encoded.
+func TestGenerateEncodeDirectives(t *testing.T) {
+	out, err := execute("directives", Options{})
+	assert.NoError(t, err)
+	assert.Equal(t, out, `{"Name":"foo","B":{"ID":1},"H":{"X":2}}{"Y":3}`)
 }
 
 // execute generates an encoder against a fixture, executes the main prorgam, and returns the results.
-func execute(name string) (ret string, err error) {
+func execute(name string, options Options) (ret string, err error) {
 	test.Test(name, func(path string) {
 		var file *ast.File
-		file, err = parser.ParseFile(token.NewFileSet(), filepath.Join(path, "types.go"), nil, 0)
+		fset := token.NewFileSet()
+		file, err = parser.ParseFile(fset, filepath.Join(path, "types.go"), nil, parser.ParseComments)
 		if err != nil {
 			return
 		}
+		pkg := model.NewPackage(fset, []*ast.File{file})
 
 		// Generate decoder.
 		f, _ := os.Create(filepath.Join(path, "encoder.go"))
-		if err = NewGenerator().Generate(f, file); err != nil {
+		if err = NewGenerator(options).Generate(f, pkg.NewFile(file)); err != nil {
 			fmt.Println("generate error:", err.Error())
 			return
 		}
@@ -1,90 +1,475 @@
 package generator
 
 import (
+	"bufio"
 	"bytes"
+	"errors"
 	"fmt"
 	"go/ast"
-	"go/parser"
+	"go/build"
+	"go/scanner"
 	"go/token"
+	"io"
 	"io/ioutil"
 	"os"
 	"path/filepath"
 	"regexp"
+	"strings"
 
 	"github.com/benbjohnson/megajson/generator/decoder"
 	"github.com/benbjohnson/megajson/generator/encoder"
+	"github.com/benbjohnson/megajson/generator/model"
 )
 
 var extregexp = regexp.MustCompile(`\.go$`)
 
+// generatedregexp matches the comment which marks a file as generated.
+var generatedregexp = regexp.MustCompile(`^// Code generated .* DO NOT EDIT\.$`)
+
 // Generator generates encoders and decoders for Go files matching a given path.
 type Generator interface {
 	Generate(path string) error
 }
 
+// ErrStale is returned in check mode when generated files are out of date.
+var ErrStale = errors.New("generated files are out of date")
+
+// DefaultFilename is the name of the file written for each package when
+// generating in package mode.
+const DefaultFilename = "megajson_gen.go"
+
+// Options represents settings for generating encoders and decoders.
+type Options struct {
+	Encoder encoder.Options
+	Decoder decoder.Options
+
+	// Package generates a single file containing the encoders and decoders
+	// for every type in a package instead of a pair of files per source file.
+	Package bool
+
+	// Filename is the name of the file written in package mode.
+	// Defaults to DefaultFilename.
+	Filename string
+
+	// Output is the path of a single file that all generated code is
+	// written to instead of the default file names. Only one file or package
+	// can be generated when it is set.
+	Output string
+
+	// Writer receives the generated code instead of any files when set.
+	// In check mode it receives the diffs of stale files instead.
+	Writer io.Writer
+
+	// Check compares generated code to the files on disk without writing
+	// them. Generate returns ErrStale if any file differs.
+	Check bool
+
+	// PackageName overrides the package name of the generated code.
+	PackageName string
+
+	// EncoderOnly and DecoderOnly limit generation to encoders or decoders.
+	// Both are generated when neither or both are set.
+	EncoderOnly bool
+	DecoderOnly bool
+
+	// Tags lists additional build tags used to select the files of a package.
+	Tags []string
+
+	// Types limits generation to the named struct types and the types they
+	// depend on. Directives select the types when it is empty.
+	Types []string
+
+	// Include limits generation to files matching at least one of these
+	// glob patterns. Patterns are matched against the file name and the
+	// slash-separated path relative to the generated path.
+	Include []string
+
+	// Exclude skips files and directories matching any of these glob
+	// patterns. Test files, generated files and vendor and testdata
+	// directories are always skipped.
+	Exclude []string
+}
+
 type generator struct {
-	decoder decoder.Generator
-	encoder encoder.Generator
+	decoder  decoder.Generator
+	encoder  encoder.Generator
+	options  Options
+	fset     *token.FileSet
+	pkgs     map[string]*model.Package
+	packages map[string]bool
+	invalid  map[string]bool
+	found    map[string]bool
+	written  bool
+	stale    bool
+	errs     []error
 }
 
-func New() Generator {
-	return &generator{decoder:decoder.NewGenerator(), encoder:encoder.NewGenerator()}
+func New(options Options) Generator {
+	if options.Filename == "" {
+		options.Filename = DefaultFilename
+	}
+	return &generator{
+		decoder:  decoder.NewGenerator(options.Decoder),
+		encoder:  encoder.NewGenerator(options.Encoder),
+		options:  options,
+		fset:     token.NewFileSet(),
+		pkgs:     make(map[string]*model.Package),
+		packages: make(map[string]bool),
+		invalid:  make(map[string]bool),
+		found:    make(map[string]bool),
+	}
 }
 
 // Generate recursively iterates over a path and generates encoders and decoders.
+// Problems with the source are collected so that all of them are returned
+// together once every file has been visited.
 func (g *generator) Generate(path string) error {
-	return filepath.Walk(path, g.walk)
+	g.errs = nil
+	err := filepath.Walk(path, func(p string, info os.FileInfo, err error) error {
+		return g.walk(path, p, info, err)
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, name := range g.options.Types {
+		if !g.found[name] {
+			g.report(fmt.Errorf("type not found: %s", name))
+		}
+	}
+	if len(g.errs) > 0 {
+		return errors.Join(g.errs...)
+	} else if g.stale {
+		return ErrStale
+	}
+	return nil
+}
+
+// report records a problem with the source. Lists of parse errors are
+// recorded individually.
+func (g *generator) report(err error) {
+	if list, ok := err.(scanner.ErrorList); ok {
+		for _, err := range list {
+			g.errs = append(g.errs, err)
+		}
+		return
+	}
+	g.errs = append(g.errs, err)
 }
 
 // walk iterates is the callback used by Generate() for iterating over files and directories.
-func (g *generator) walk(path string, info os.FileInfo, err error) error {
+func (g *generator) walk(root, path string, info os.FileInfo, err error) error {
 	// Only go file are used for generation.
 	if info == nil {
 		return fmt.Errorf("file not found: %s", path)
-	} else if info.IsDir() || filepath.Ext(path) != ".go" {
+	} else if info.IsDir() {
+		if path != root && g.ignore(root, path, true) {
+			return filepath.SkipDir
+		}
+		return nil
+	} else if filepath.Ext(path) != ".go" || g.ignore(root, path, false) {
+		return nil
+	}
+
+	// Never generate from generated code.
+	if ok, err := isGenerated(path); err != nil {
+		return err
+	} else if ok {
 		return nil
 	}
 
-	// Parse Go file.
-	file, err := parser.ParseFile(token.NewFileSet(), path, nil, 0)
+	// Type check the package containing the file.
+	pkg, err := g.load(filepath.Dir(path))
+	if _, ok := err.(*build.NoGoError); ok {
+		return nil
+	} else if err != nil {
+		g.report(err)
+		return nil
+	} else if pkg == nil {
+		return nil
+	}
+
+	// Generate the whole package at once in package mode.
+	if g.options.Package {
+		return g.generatePackage(root, filepath.Dir(path), pkg, info.Mode())
+	}
+
+	// Generated files call the encoders and decoders of types declared in
+	// other files so none are written for a package with diagnostics.
+	if ok, err := g.diagnosePackage(root, filepath.Dir(path), pkg); err != nil {
+		return err
+	} else if ok {
+		return nil
+	}
+
+	// Ignore files which are excluded from the package by build constraints.
+	f := pkg.File(path)
+	if f == nil {
+		return nil
+	}
+	enc, dec, err := g.source(g.newFile(pkg, f))
 	if err != nil {
+		g.report(err)
+		return nil
+	}
+
+	// Write a single file when the output is set and a pair otherwise.
+	if g.options.Output != "" || (g.options.Writer != nil && !g.options.Check) {
+		b, err := model.Merge(nonempty(enc, dec)...)
+		if err != nil {
+			return err
+		}
+		return g.write(g.options.Output, b, info.Mode())
+	}
+	if err := g.write(extregexp.ReplaceAllString(path, "_encoder.go"), enc, info.Mode()); err != nil {
 		return err
 	}
+	return g.write(extregexp.ReplaceAllString(path, "_decoder.go"), dec, info.Mode())
+}
+
+// load parses and type checks the package in a directory.
+// Packages are cached so each directory is only loaded once. Packages which
+// fail to load are cached as nil so their error is only returned once.
+func (g *generator) load(dir string) (*model.Package, error) {
+	if pkg, ok := g.pkgs[dir]; ok {
+		return pkg, nil
+	}
+	g.pkgs[dir] = nil
+	pkg, err := model.Load(g.fset, dir, g.options.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	// Select the types named on the command line.
+	if len(g.options.Types) > 0 {
+		if err := pkg.Select(g.options.Types); err != nil {
+			return nil, err
+		}
+		for _, name := range g.options.Types {
+			if pkg.Types.Scope().Lookup(name) != nil {
+				g.found[name] = true
+			}
+		}
+	}
+	g.pkgs[dir] = pkg
+	return pkg, nil
+}
+
+// generatePackage writes the encoders and decoders for every type in a
+// package to a single file. Each package is only generated once.
+func (g *generator) generatePackage(root, dir string, pkg *model.Package, mode os.FileMode) error {
+	if g.packages[dir] {
+		return nil
+	}
+	g.packages[dir] = true
 
-	if err := g.encode(file, extregexp.ReplaceAllString(path, "_encoder.go"), info.Mode()); err != nil {
+	// Never generate from the output file.
+	output := g.options.Output
+	if output == "" {
+		output = filepath.Join(dir, g.options.Filename)
+	}
+	files, err := g.files(root, pkg, output)
+	if err != nil {
 		return err
 	}
-	if err := g.decode(file, extregexp.ReplaceAllString(path, "_decoder.go"), info.Mode()); err != nil {
+
+	file := g.newFile(pkg, files...)
+	if g.diagnose(file) {
+		return nil
+	}
+	enc, dec, err := g.source(file)
+	if err != nil {
+		g.report(err)
+		return nil
+	}
+	b, err := model.Merge(nonempty(enc, dec)...)
+	if err != nil {
 		return err
 	}
+	return g.write(output, b, mode)
+}
 
-	return nil
+// diagnosePackage reports the diagnostics of every file in a package that
+// code is generated from and returns true if there are any. Each package is
+// only diagnosed once.
+func (g *generator) diagnosePackage(root, dir string, pkg *model.Package) (bool, error) {
+	if ok, seen := g.invalid[dir]; seen {
+		return ok, nil
+	}
+	files, err := g.files(root, pkg, g.options.Output)
+	if err != nil {
+		return false, err
+	}
+	g.invalid[dir] = g.diagnose(g.newFile(pkg, files...))
+	return g.invalid[dir], nil
+}
+
+// files returns the files of a package that code is generated from. These
+// are the same files as a directory walk visits except for the output file.
+func (g *generator) files(root string, pkg *model.Package, output string) ([]*ast.File, error) {
+	var files []*ast.File
+	for _, f := range pkg.Files {
+		path := g.fset.File(f.Pos()).Name()
+		if path == output || g.ignore(root, path, false) {
+			continue
+		}
+		if ok, err := isGenerated(path); err != nil {
+			return nil, err
+		} else if !ok {
+			files = append(files, f)
+		}
+	}
+	return files, nil
+}
+
+// newFile returns the model for a set of files using the package name option.
+func (g *generator) newFile(pkg *model.Package, files ...*ast.File) *model.File {
+	file := pkg.NewFile(files...)
+	if g.options.PackageName != "" {
+		file.Name = g.options.PackageName
+	}
+	return file
+}
+
+// diagnose reports the diagnostics of a file and returns true if it has any.
+func (g *generator) diagnose(file *model.File) bool {
+	for _, d := range file.Diagnostics {
+		g.report(d)
+	}
+	return len(file.Diagnostics) > 0
+}
+
+// source generates the encoder and decoder source for a file. Either is
+// empty if it is disabled or has no types.
+func (g *generator) source(file *model.File) (enc, dec []byte, err error) {
+	both := g.options.EncoderOnly == g.options.DecoderOnly
+
+	var e, d bytes.Buffer
+	if both || g.options.EncoderOnly {
+		if err := g.encoder.Generate(&e, file); err != nil {
+			return nil, nil, fmt.Errorf("%s: generating encoder: %s", g.fset.Position(file.Pos), err)
+		}
+	}
+	if both || g.options.DecoderOnly {
+		if err := g.decoder.Generate(&d, file); err != nil {
+			return nil, nil, fmt.Errorf("%s: generating decoder: %s", g.fset.Position(file.Pos), err)
+		}
+	}
+	return e.Bytes(), d.Bytes(), nil
 }
 
-// decode generates a decoder file from a given Go file.
-func (g *generator) decode(file *ast.File, path string, mode os.FileMode) error {
-	var b bytes.Buffer
-	if err := g.decoder.Generate(&b, file); err != nil {
+// write writes generated source to a path or to the writer option.
+// Nothing is written for empty source.
+func (g *generator) write(path string, b []byte, mode os.FileMode) error {
+	if g.options.Check {
+		return g.check(path, b)
+	} else if len(b) == 0 {
+		return nil
+	} else if g.options.Writer != nil {
+		_, err := g.options.Writer.Write(b)
 		return err
 	}
-	if b.Len() > 0 {
-		if err := ioutil.WriteFile(path, b.Bytes(), mode); err != nil {
-			return err
+
+	// Only one set of generated code can be written to the output.
+	if g.options.Output != "" {
+		if g.written {
+			return fmt.Errorf("multiple files generated for output: %s", g.options.Output)
 		}
+		g.written = true
 	}
-	return nil
+	return ioutil.WriteFile(path, b, mode)
 }
 
-// encode generates an encoder file from a given Go file.
-func (g *generator) encode(file *ast.File, path string, mode os.FileMode) error {
-	var b bytes.Buffer
-	if err := g.encoder.Generate(&b, file); err != nil {
+// check compares generated source to the file at a path and writes a diff
+// if they differ. Generated files which would no longer be written are also
+// stale.
+func (g *generator) check(path string, b []byte) error {
+	existing, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		existing = nil
+	} else if err != nil {
 		return err
+	} else if len(b) == 0 {
+		if ok, err := isGenerated(path); err != nil || !ok {
+			return err
+		}
 	}
-	if b.Len() > 0 {
-		if err := ioutil.WriteFile(path, b.Bytes(), mode); err != nil {
+
+	d := diff(filepath.ToSlash(path), existing, b)
+	if d == "" {
+		return nil
+	}
+	g.stale = true
+	if g.options.Writer != nil {
+		if _, err := io.WriteString(g.options.Writer, d); err != nil {
 			return err
 		}
 	}
 	return nil
 }
+
+// nonempty returns the non-empty source files from a list.
+func nonempty(srcs ...[]byte) [][]byte {
+	var a [][]byte
+	for _, src := range srcs {
+		if len(src) > 0 {
+			a = append(a, src)
+		}
+	}
+	return a
+}
+
+// ignore returns true if a file or directory should be skipped.
+func (g *generator) ignore(root, path string, dir bool) bool {
+	name := filepath.Base(path)
+	if dir {
+		switch name {
+		case "vendor", "testdata":
+			return true
+		}
+	} else if strings.HasSuffix(name, "_test.go") {
+		return true
+	}
+
+	if match(g.options.Exclude, root, path) {
+		return true
+	}
+	return !dir && len(g.options.Include) > 0 && !match(g.options.Include, root, path)
+}
+
+// match returns true if a path matches any of a set of glob patterns by
+// name or by its path relative to root.
+func match(patterns []string, root, path string) bool {
+	rel, err := filepath.Rel(root, path)
+	if err != nil {
+		rel = path
+	}
+	for _, pattern := range patterns {
+		if ok, _ := filepath.Match(pattern, filepath.Base(path)); ok {
+			return true
+		} else if ok, _ := filepath.Match(pattern, filepath.ToSlash(rel)); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// isGenerated returns true if a file has a "// Code generated ... DO NOT EDIT."
+// comment before its package clause.
+func isGenerated(path string) (bool, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		if generatedregexp.MatchString(s.Text()) {
+			return true, nil
+		} else if strings.HasPrefix(s.Text(), "package ") {
+			break
+		}
+	}
+	return false, s.Err()
+}
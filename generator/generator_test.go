@@ -0,0 +1,174 @@
+package generator
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/benbjohnson/megajson/generator/test"
+	"github.com/stretchr/testify/assert"
+)
+
+// Ensures that package mode writes one file for all types in a package and
+// that regenerating it produces the same output.
+func TestGeneratePackage(t *testing.T) {
+	test.Test("package", func(path string) {
+		assert.NoError(t, New(Options{Package: true}).Generate(path))
+		b, err := ioutil.ReadFile(filepath.Join(path, DefaultFilename))
+		assert.NoError(t, err)
+
+		assert.NoError(t, New(Options{Package: true}).Generate(path))
+		regenerated, _ := ioutil.ReadFile(filepath.Join(path, DefaultFilename))
+		assert.Equal(t, string(regenerated), string(b))
+
+		files, _ := filepath.Glob(filepath.Join(path, "*.go"))
+		out, _ := exec.Command("go", append([]string{"run"}, files...)...).CombinedOutput()
+		assert.Equal(t, string(out), `{"Name":"foo","B":{"ID":1,"Tags":["x"]},"Bs":[{"ID":2,"Tags":null}]}`)
+	})
+}
+
+// Ensures that generated, test, vendor and testdata files are skipped and
+// that include and exclude patterns are honored.
+func TestGenerateSkip(t *testing.T) {
+	path, _ := ioutil.TempDir("", "")
+	defer os.RemoveAll(path)
+	write := func(name, src string) {
+		os.MkdirAll(filepath.Dir(filepath.Join(path, name)), 0700)
+		ioutil.WriteFile(filepath.Join(path, name), []byte(src), 0600)
+	}
+	write("a.go", "package foo\ntype A struct { X int }\n")
+	write("b.go", "package foo\ntype B struct { X int }\n")
+	write("a_test.go", "package foo\ntype T struct { X int }\n")
+	write("gen.go", "// Code generated by hand. DO NOT EDIT.\n\npackage foo\ntype G struct { X int }\n")
+	write("vendor/v/v.go", "package v\ntype V struct { X int }\n")
+	write("testdata/d.go", "package d\ntype D struct { X int }\n")
+	write("sub/c.go", "package sub\ntype C struct { X int }\n")
+
+	exists := func(name string) bool {
+		_, err := os.Stat(filepath.Join(path, name))
+		return err == nil
+	}
+
+	assert.NoError(t, New(Options{Exclude: []string{"b.go", "sub"}}).Generate(path))
+	assert.True(t, exists("a_encoder.go"))
+	assert.True(t, exists("a_decoder.go"))
+	assert.False(t, exists("b_encoder.go"))
+	assert.False(t, exists("a_test_encoder.go"))
+	assert.False(t, exists("gen_encoder.go"))
+	assert.False(t, exists("vendor/v/v_encoder.go"))
+	assert.False(t, exists("testdata/d_encoder.go"))
+	assert.False(t, exists("sub/c_encoder.go"))
+
+	// Generated files are not used as input when generating again.
+	assert.NoError(t, New(Options{Include: []string{"sub/*.go", "a*.go"}}).Generate(path))
+	assert.False(t, exists("a_encoder_encoder.go"))
+	assert.False(t, exists("b_encoder.go"))
+	assert.True(t, exists("sub/c_encoder.go"))
+
+	assert.EqualError(t, New(Options{Types: []string{"Missing"}}).Generate(path), "type not found: Missing")
+}
+
+// Ensures that output, package name, encoder, build tag and writer options
+// are honored.
+func TestGenerateOptions(t *testing.T) {
+	path, _ := ioutil.TempDir("", "")
+	defer os.RemoveAll(path)
+	ioutil.WriteFile(filepath.Join(path, "a.go"), []byte("package foo\ntype A struct { X int }\n"), 0600)
+	ioutil.WriteFile(filepath.Join(path, "b.go"), []byte("//go:build extra\n\npackage foo\ntype B struct { X int }\n"), 0600)
+
+	output := filepath.Join(path, "out.go")
+	assert.NoError(t, New(Options{Output: output, EncoderOnly: true}).Generate(filepath.Join(path, "a.go")))
+	b, _ := ioutil.ReadFile(output)
+	assert.Contains(t, string(b), "func NewAJSONEncoder(")
+	assert.NotContains(t, string(b), "func NewAJSONDecoder(")
+
+	var buf bytes.Buffer
+	assert.NoError(t, New(Options{Writer: &buf, PackageName: "bar", DecoderOnly: true, Tags: []string{"extra"}, Package: true}).Generate(path))
+	assert.Contains(t, buf.String(), "package bar\n")
+	assert.Contains(t, buf.String(), "func NewAJSONDecoder(")
+	assert.Contains(t, buf.String(), "func NewBJSONDecoder(")
+	assert.NotContains(t, buf.String(), "func NewAJSONEncoder(")
+
+	buf.Reset()
+	assert.NoError(t, New(Options{Writer: &buf, Package: true}).Generate(path))
+	assert.NotContains(t, buf.String(), "func NewBJSONDecoder(")
+
+	ioutil.WriteFile(filepath.Join(path, "c.go"), []byte("package foo\ntype C struct { X int }\n"), 0600)
+	assert.EqualError(t, New(Options{Output: output}).Generate(path), "multiple files generated for output: "+output)
+}
+
+// Ensures that check mode reports stale files without writing them.
+func TestGenerateCheck(t *testing.T) {
+	path, _ := ioutil.TempDir("", "")
+	defer os.RemoveAll(path)
+	ioutil.WriteFile(filepath.Join(path, "a.go"), []byte("package foo\ntype A struct { X int }\n"), 0600)
+	assert.NoError(t, New(Options{}).Generate(path))
+	b, _ := ioutil.ReadFile(filepath.Join(path, "a_encoder.go"))
+
+	var buf bytes.Buffer
+	assert.NoError(t, New(Options{Check: true, Writer: &buf}).Generate(path))
+	assert.Equal(t, buf.String(), "")
+
+	ioutil.WriteFile(filepath.Join(path, "a.go"), []byte("package foo\ntype A struct { X, Y int }\n"), 0600)
+	assert.Equal(t, New(Options{Check: true, Writer: &buf}).Generate(path), ErrStale)
+	assert.Contains(t, buf.String(), "+++ "+filepath.ToSlash(filepath.Join(path, "a_encoder.go"))+"\n")
+	assert.Contains(t, buf.String(), "+++ "+filepath.ToSlash(filepath.Join(path, "a_decoder.go"))+"\n")
+	assert.Contains(t, buf.String(), "+\t\tif err := e.w.WriteString(\"Y\"); err != nil {\n")
+
+	unchanged, _ := ioutil.ReadFile(filepath.Join(path, "a_encoder.go"))
+	assert.Equal(t, string(unchanged), string(b))
+}
+
+// Ensures that diffs are written in the unified format.
+func TestDiff(t *testing.T) {
+	a := "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12\n"
+	b := "1\n2\nthree\n4\n5\n6\n7\n8\n9\n10\n11\n12\n13\n"
+	assert.Equal(t, diff("x.go", []byte(a), []byte(a)), "")
+	assert.Equal(t, diff("x.go", []byte(a), []byte(b)), "--- x.go.orig\n+++ x.go\n"+
+		"@@ -1,6 +1,6 @@\n 1\n 2\n-3\n+three\n 4\n 5\n 6\n"+
+		"@@ -10,3 +10,4 @@\n 10\n 11\n 12\n+13\n")
+	assert.Equal(t, diff("x.go", nil, []byte("1\n")), "--- x.go.orig\n+++ x.go\n@@ -0,0 +1 @@\n+1\n")
+}
+
+// Ensures that all diagnostics are reported with their positions and that
+// code is not generated for any file of a package with diagnostics since the
+// generated files of a package depend on each other. Code is still generated
+// for packages without problems.
+func TestGenerateDiagnostics(t *testing.T) {
+	path, _ := ioutil.TempDir("", "")
+	defer os.RemoveAll(path)
+	write := func(name, src string) {
+		os.MkdirAll(filepath.Dir(filepath.Join(path, name)), 0700)
+		ioutil.WriteFile(filepath.Join(path, name), []byte(src), 0600)
+	}
+	write("a.go", "package foo\n\ntype A struct {\n\tID      int\n\tCreated chan int\n\tF       func()\n}\n")
+	write("b.go", "package foo\ntype B struct { A *A; X int }\n")
+	write("imp/e.go", "package imp\nimport \"example.com/missing\"\ntype E struct { M missing.T }\n")
+	write("ok/d.go", "package ok\ntype D struct { X int }\n")
+	write("sub/c.go", "package sub\ntype C struct {\n")
+
+	err := New(Options{}).Generate(path)
+	assert.Error(t, err)
+	errs := strings.Split(err.Error(), "\n")
+	assert.Equal(t, len(errs), 5)
+	assert.Equal(t, errs[:2], []string{
+		filepath.Join(path, "a.go") + ":5:2: field Created has unsupported type chan int",
+		filepath.Join(path, "a.go") + ":6:2: field F has unsupported type func()",
+	})
+	assert.True(t, strings.HasPrefix(errs[2], filepath.Join(path, "imp/e.go")+":2:8: could not import example.com/missing"))
+	assert.Equal(t, errs[3:], []string{
+		filepath.Join(path, "imp/e.go") + ":3:17: field M has an invalid type",
+		filepath.Join(path, "sub/c.go") + ":2:17: expected '}', found 'EOF'",
+	})
+
+	for _, name := range []string{"a_encoder.go", "b_encoder.go", "b_decoder.go", "imp/e_encoder.go"} {
+		_, err = os.Stat(filepath.Join(path, name))
+		assert.True(t, os.IsNotExist(err), name)
+	}
+	_, err = os.Stat(filepath.Join(path, "ok/d_encoder.go"))
+	assert.NoError(t, err)
+}
@@ -0,0 +1,60 @@
+package model
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+)
+
+// Diagnostic represents a problem with the source code at a position.
+type Diagnostic struct {
+	Pos token.Position
+	Msg string
+}
+
+// Error returns the diagnostic prefixed by its position.
+func (d *Diagnostic) Error() string {
+	return d.Pos.String() + ": " + d.Msg
+}
+
+// typeErrors returns the type checking errors within a node as diagnostics.
+func (p *Package) typeErrors(node ast.Node) []*Diagnostic {
+	var diags []*Diagnostic
+	for _, err := range p.Errors {
+		if err, ok := err.(types.Error); ok && err.Pos >= node.Pos() && err.Pos < node.End() {
+			diags = append(diags, &Diagnostic{Pos: p.Fset.Position(err.Pos), Msg: err.Msg})
+		}
+	}
+	return diags
+}
+
+// importErrors returns the type checking errors of the imports in a file
+// which are used by a set of nodes. The types from an import which cannot be
+// resolved are invalid without any errors where they are used.
+func (p *Package) importErrors(f *ast.File, nodes []ast.Node) []*Diagnostic {
+	used := make(map[types.Object]bool)
+	for _, node := range nodes {
+		ast.Inspect(node, func(n ast.Node) bool {
+			if sel, ok := n.(*ast.SelectorExpr); ok {
+				if ident, ok := sel.X.(*ast.Ident); ok {
+					if obj, ok := p.Info.Uses[ident].(*types.PkgName); ok {
+						used[obj] = true
+					}
+				}
+			}
+			return true
+		})
+	}
+
+	var diags []*Diagnostic
+	for _, spec := range f.Imports {
+		obj := p.Info.Implicits[spec]
+		if spec.Name != nil {
+			obj = p.Info.Defs[spec.Name]
+		}
+		if obj != nil && used[obj] {
+			diags = append(diags, p.typeErrors(spec)...)
+		}
+	}
+	return diags
+}
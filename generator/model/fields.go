@@ -0,0 +1,243 @@
+package model
+
+import (
+	"fmt"
+	"go/types"
+	"sort"
+)
+
+// candidate represents a field found while walking embedded structs.
+type candidate struct {
+	field   *types.Var
+	name    string
+	options []string
+	tagged  bool
+	index   []int
+}
+
+// embeddedStruct represents a struct type to search for fields.
+type embeddedStruct struct {
+	typ   types.Type
+	index []int
+}
+
+// fields returns the fields of a struct type that are encoded as JSON keys.
+// The fields of embedded structs are promoted into the parent using the same
+// rules as the encoding/json package. Fields with the same name at the
+// shallowest depth cancel each other out unless exactly one is tagged.
+// Fields with types that cannot be encoded and fields declared directly in
+// the struct with the same key are left out and reported as diagnostics.
+func (p *Package) fields(typ types.Type) ([]*Field, []*Diagnostic) {
+	var candidates []*candidate
+
+	current, next := []embeddedStruct{}, []embeddedStruct{{typ: typ}}
+	count, nextCount := map[types.Type]int{}, map[types.Type]int{}
+	visited := map[types.Type]bool{}
+
+	for len(next) > 0 {
+		current, next = next, current[:0]
+		count, nextCount = nextCount, map[types.Type]int{}
+
+		for _, es := range current {
+			if visited[es.typ] {
+				continue
+			}
+			visited[es.typ] = true
+
+			// Only exported fields are accessible on structs from other packages.
+			st := es.typ.Underlying().(*types.Struct)
+			foreign := p.isForeign(es.typ)
+
+			for i := 0; i < st.NumFields(); i++ {
+				v := st.Field(i)
+				if foreign && !v.Exported() {
+					continue
+				}
+
+				// Determine the type of an embedded struct.
+				ft := v.Type()
+				if ptr, ok := types.Unalias(ft).(*types.Pointer); ok {
+					ft = ptr.Elem()
+				}
+				_, isStruct := ft.Underlying().(*types.Struct)
+				if v.Embedded() && !v.Exported() && !isStruct {
+					continue
+				}
+
+				// Skip fields which are ignored by their tag.
+				name, options := parseTag(st.Tag(i))
+				if name == "-" && len(options) == 0 {
+					continue
+				}
+
+				index := make([]int, len(es.index)+1)
+				copy(index, es.index)
+				index[len(es.index)] = i
+
+				// Record fields that are not embedded structs.
+				if name != "" || !v.Embedded() || !isStruct {
+					c := &candidate{field: v, name: name, options: options, tagged: name != "", index: index}
+					if c.name == "" {
+						c.name = v.Name()
+					}
+					candidates = append(candidates, c)
+
+					// If the struct appeared multiple times at this depth then
+					// add a duplicate so that the field is dropped below.
+					if count[es.typ] > 1 {
+						candidates = append(candidates, c)
+					}
+					continue
+				}
+
+				// Search the embedded struct at the next depth.
+				nextCount[ft]++
+				if nextCount[ft] == 1 {
+					next = append(next, embeddedStruct{typ: ft, index: index})
+				}
+			}
+		}
+	}
+
+	// Sort by name, breaking ties with depth, then tagging, then index sequence.
+	sort.Slice(candidates, func(i, j int) bool {
+		x, y := candidates[i], candidates[j]
+		if x.name != y.name {
+			return x.name < y.name
+		} else if len(x.index) != len(y.index) {
+			return len(x.index) < len(y.index)
+		} else if x.tagged != y.tagged {
+			return x.tagged
+		}
+		return lessIndex(x.index, y.index)
+	})
+
+	// Remove hidden and conflicting fields.
+	var diags []*Diagnostic
+	var dominant []*candidate
+	for i := 0; i < len(candidates); {
+		j := i + 1
+		for j < len(candidates) && candidates[j].name == candidates[i].name {
+			j++
+		}
+		if c := dominantField(candidates[i:j]); c != nil {
+			dominant = append(dominant, c)
+		} else if len(candidates[i].index) == 1 {
+			diags = append(diags, p.conflicts(candidates[i:j])...)
+		}
+		i = j
+	}
+
+	// Restore the declaration order.
+	sort.Slice(dominant, func(i, j int) bool {
+		return lessIndex(dominant[i].index, dominant[j].index)
+	})
+
+	fields := make([]*Field, 0, len(dominant))
+	for _, c := range dominant {
+		if !isValid(c.field.Type()) {
+			diags = append(diags, &Diagnostic{
+				Pos: p.Fset.Position(c.field.Pos()),
+				Msg: fmt.Sprintf("field %s has an invalid type", c.field.Name()),
+			})
+			continue
+		} else if p.Kind(c.field.Type()) == "" {
+			diags = append(diags, &Diagnostic{
+				Pos: p.Fset.Position(c.field.Pos()),
+				Msg: fmt.Sprintf("field %s has unsupported type %s", c.field.Name(), p.TypeString(c.field.Type())),
+			})
+			continue
+		}
+		fields = append(fields, p.newField(typ, c))
+	}
+	return fields, diags
+}
+
+// dominantField returns the field which takes precedence from a list of
+// fields sharing the same name and sorted by depth and tagging. Returns nil
+// if no single field takes precedence.
+func dominantField(candidates []*candidate) *candidate {
+	if len(candidates) > 1 && len(candidates[0].index) == len(candidates[1].index) && candidates[0].tagged == candidates[1].tagged {
+		return nil
+	}
+	return candidates[0]
+}
+
+// conflicts returns a diagnostic for each field declared directly in a struct
+// which has the same key as an earlier field.
+func (p *Package) conflicts(candidates []*candidate) []*Diagnostic {
+	var a []*candidate
+	for _, c := range candidates {
+		if len(c.index) == 1 {
+			a = append(a, c)
+		}
+	}
+	sort.Slice(a, func(i, j int) bool { return a[i].index[0] < a[j].index[0] })
+
+	var diags []*Diagnostic
+	for _, c := range a[1:] {
+		diags = append(diags, &Diagnostic{
+			Pos: p.Fset.Position(c.field.Pos()),
+			Msg: fmt.Sprintf("field %s has the same JSON key %q as field %s", c.field.Name(), c.name, a[0].field.Name()),
+		})
+	}
+	return diags
+}
+
+// newField returns the model for a field found by its index sequence.
+func (p *Package) newField(typ types.Type, c *candidate) *Field {
+	field := &Field{Key: c.name, Type: c.field.Type()}
+
+	// Build the selector through any embedded structs.
+	for _, i := range c.index[:len(c.index)-1] {
+		v := typ.Underlying().(*types.Struct).Field(i)
+		if field.Name != "" {
+			field.Name += "."
+		}
+		field.Name += v.Name()
+
+		typ = v.Type()
+		if ptr, ok := types.Unalias(typ).(*types.Pointer); ok {
+			field.Embedded = append(field.Embedded, &Embedded{Name: field.Name, Type: typ})
+			typ = ptr.Elem()
+		}
+	}
+	if field.Name != "" {
+		field.Name += "."
+	}
+	field.Name += c.field.Name()
+
+	for _, option := range c.options {
+		switch option {
+		case "omitempty":
+			_, isStruct := field.Type.Underlying().(*types.Struct)
+			field.OmitEmpty = !isStruct
+		case "string":
+			// Pointers to basic types are quoted as well, like encoding/json.
+			typ := field.Type
+			if ptr, ok := types.Unalias(typ).(*types.Pointer); ok {
+				typ = ptr.Elem()
+			}
+			field.Quoted = Basic(typ) != "" && p.Kind(typ) != "marshaler" && p.Kind(field.Type) != "marshaler"
+		}
+	}
+	return field
+}
+
+// isForeign returns true if a named type is declared in another package.
+func (p *Package) isForeign(t types.Type) bool {
+	named, ok := types.Unalias(t).(*types.Named)
+	return ok && named.Obj().Pkg() != p.Types
+}
+
+// lessIndex returns true if index sequence a sorts before b.
+func lessIndex(a, b []int) bool {
+	for i := range a {
+		if i >= len(b) {
+			return false
+		} else if a[i] != b[i] {
+			return a[i] < b[i]
+		}
+	}
+	return len(a) < len(b)
+}
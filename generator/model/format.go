@@ -0,0 +1,103 @@
+package model
+
+import (
+	"bytes"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"path"
+	"strconv"
+)
+
+// Format removes unused imports from generated Go source and then formats
+// it. Templates import every package they could need so only the imports
+// used by a given set of types remain.
+func Format(src []byte) ([]byte, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "", src, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	// Find all identifiers used as qualifiers.
+	used := make(map[string]bool)
+	ast.Inspect(f, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if ident, ok := sel.X.(*ast.Ident); ok {
+				used[ident.Name] = true
+			}
+		}
+		return true
+	})
+
+	// Remove the lines of unused or duplicate imports.
+	// Templates write each import on its own line.
+	unused := make(map[int]bool)
+	seen := make(map[string]bool)
+	for _, spec := range f.Imports {
+		importPath, _ := strconv.Unquote(spec.Path.Value)
+		name := path.Base(importPath)
+		if spec.Name != nil {
+			name = spec.Name.Name
+		}
+		if !used[name] || seen[importPath] {
+			unused[fset.Position(spec.Pos()).Line] = true
+		}
+		seen[importPath] = true
+	}
+	var buf bytes.Buffer
+	for i, line := range bytes.SplitAfter(src, []byte("\n")) {
+		if !unused[i+1] {
+			buf.Write(line)
+		}
+	}
+
+	return format.Source(buf.Bytes())
+}
+
+// Merge combines generated Go source files from the same package into a
+// single file. The package clause is taken from the first file, the imports
+// of every file are combined and the remaining declarations are appended in
+// order. Returns nil if there are no files.
+func Merge(srcs ...[]byte) ([]byte, error) {
+	if len(srcs) == 0 {
+		return nil, nil
+	}
+
+	var header, imports, body bytes.Buffer
+	for i, src := range srcs {
+		fset := token.NewFileSet()
+		f, err := parser.ParseFile(fset, "", src, 0)
+		if err != nil {
+			return nil, err
+		}
+		offset := func(pos token.Pos) int {
+			return fset.Position(pos).Offset
+		}
+
+		start := offset(f.Name.End())
+		if i == 0 {
+			header.Write(src[:start])
+		}
+		for _, spec := range f.Imports {
+			imports.Write(src[offset(spec.Pos()):offset(spec.End())])
+			imports.WriteByte('\n')
+		}
+		for _, decl := range f.Decls {
+			if decl, ok := decl.(*ast.GenDecl); ok && decl.Tok == token.IMPORT {
+				start = offset(decl.End())
+			}
+		}
+		body.Write(src[start:])
+		body.WriteByte('\n')
+	}
+
+	var buf bytes.Buffer
+	buf.Write(header.Bytes())
+	buf.WriteString("\n\nimport (\n")
+	buf.Write(imports.Bytes())
+	buf.WriteString(")\n")
+	buf.Write(body.Bytes())
+	return Format(buf.Bytes())
+}
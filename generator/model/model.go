@@ -0,0 +1,195 @@
+package model
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+	"reflect"
+	"strings"
+)
+
+// File represents the struct types declared in a single Go file that
+// encoders and decoders are generated for.
+type File struct {
+	Name    string
+	Pos     token.Pos
+	Package *Package
+	Types   []*Type
+	Imports []*types.Package
+
+	// Diagnostics lists the problems found in the types which prevent code
+	// from being generated.
+	Diagnostics []*Diagnostic
+}
+
+// Type represents a named struct type.
+type Type struct {
+	Name   string
+	Fields []*Field
+
+	// Encode and Decode are set when an encoder or decoder is generated.
+	Encode bool
+	Decode bool
+}
+
+// HasOptionalFields returns true if any field of the type can be omitted.
+func (t *Type) HasOptionalFields() bool {
+	for _, field := range t.Fields {
+		if field.Optional() {
+			return true
+		}
+	}
+	return false
+}
+
+// Field represents a struct field that is encoded as a JSON key.
+type Field struct {
+	Name string
+	Key  string
+	Type types.Type
+
+	// OmitEmpty is set when the field is skipped while encoding an empty value.
+	OmitEmpty bool
+
+	// Quoted is set when the value is encoded inside of a JSON string.
+	Quoted bool
+
+	// Embedded lists the embedded struct pointers that a promoted field is
+	// accessed through, from outermost to innermost.
+	Embedded []*Embedded
+}
+
+// Optional returns true if the field is not always encoded.
+func (f *Field) Optional() bool {
+	return f.OmitEmpty || len(f.Embedded) > 0
+}
+
+// Embedded represents an embedded pointer to a struct.
+type Embedded struct {
+	Name string
+	Type types.Type
+}
+
+// NewFile returns the model for the struct types declared in one or more
+// files of the package.
+func (p *Package) NewFile(files ...*ast.File) *File {
+	file := &File{Package: p}
+	for _, f := range files {
+		file.Name = f.Name.Name
+		if !file.Pos.IsValid() {
+			file.Pos = f.Package
+		}
+
+		// Type errors are reported for the declarations of selected types
+		// and the imports they use.
+		var specs []ast.Node
+		var diags []*Diagnostic
+		for _, decl := range f.Decls {
+			if decl, ok := decl.(*ast.GenDecl); ok {
+				for _, spec := range decl.Specs {
+					if spec, ok := spec.(*ast.TypeSpec); ok {
+						if typ, d := p.newType(spec); typ != nil {
+							file.Types = append(file.Types, typ)
+							specs = append(specs, spec)
+							diags = append(diags, p.typeErrors(spec)...)
+							diags = append(diags, d...)
+						}
+					}
+				}
+			}
+		}
+		file.Diagnostics = append(file.Diagnostics, p.importErrors(f, specs)...)
+		file.Diagnostics = append(file.Diagnostics, diags...)
+	}
+	file.Imports = p.imports(file.Types)
+	return file
+}
+
+// Encoders returns the types that encoders are generated for.
+func (f *File) Encoders() []*Type {
+	var typs []*Type
+	for _, typ := range f.Types {
+		if typ.Encode {
+			typs = append(typs, typ)
+		}
+	}
+	return typs
+}
+
+// Decoders returns the types that decoders are generated for.
+func (f *File) Decoders() []*Type {
+	var typs []*Type
+	for _, typ := range f.Types {
+		if typ.Decode {
+			typs = append(typs, typ)
+		}
+	}
+	return typs
+}
+
+// imports returns the packages referenced by the fields of a set of types.
+func (p *Package) imports(typs []*Type) []*types.Package {
+	var pkgs []*types.Package
+	seen := make(map[*types.Package]bool)
+
+	var visit func(types.Type)
+	visit = func(t types.Type) {
+		switch typ := types.Unalias(t).(type) {
+		case *types.Named:
+			if pkg := typ.Obj().Pkg(); pkg != nil && pkg != p.Types && !seen[pkg] {
+				seen[pkg] = true
+				pkgs = append(pkgs, pkg)
+			}
+		case *types.Pointer:
+			visit(typ.Elem())
+		case *types.Slice:
+			visit(typ.Elem())
+		case *types.Array:
+			visit(typ.Elem())
+		case *types.Map:
+			visit(typ.Key())
+			visit(typ.Elem())
+		}
+	}
+	for _, typ := range typs {
+		for _, field := range typ.Fields {
+			visit(field.Type)
+			for _, embedded := range field.Embedded {
+				visit(embedded.Type)
+			}
+		}
+	}
+	return pkgs
+}
+
+// newType returns the model for a type spec and the problems with its fields.
+// Returns nil if the spec does not declare a selected struct type.
+func (p *Package) newType(spec *ast.TypeSpec) (*Type, []*Diagnostic) {
+	if spec.Assign.IsValid() || spec.TypeParams != nil {
+		return nil, nil
+	}
+	obj, ok := p.Info.Defs[spec.Name].(*types.TypeName)
+	if !ok {
+		return nil, nil
+	}
+	if _, ok := obj.Type().Underlying().(*types.Struct); !ok {
+		return nil, nil
+	}
+	dir := p.selected[obj]
+	if dir == 0 {
+		return nil, nil
+	}
+	fields, diags := p.fields(obj.Type())
+	return &Type{
+		Name:   obj.Name(),
+		Fields: fields,
+		Encode: dir&encode != 0,
+		Decode: dir&decode != 0,
+	}, diags
+}
+
+// parseTag returns the JSON key name and options from a struct field tag.
+func parseTag(tag string) (string, []string) {
+	tags := strings.Split(reflect.StructTag(tag).Get("json"), ",")
+	return tags[0], tags[1:]
+}
@@ -0,0 +1,257 @@
+package model
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Ensures that fields are resolved to their types.
+func TestNewFile(t *testing.T) {
+	file := parse(t, `
+package foo
+type ID int64
+type Foo struct {
+    ID ID
+    Name string `+"`json:\"name\"`"+`
+    Ignore string `+"`json:\"-\"`"+`
+    b byte
+}
+`)
+	assert.Equal(t, file.Name, "foo")
+	assert.Equal(t, len(file.Types), 1)
+	assert.Equal(t, file.Types[0].Name, "Foo")
+
+	fields := file.Types[0].Fields
+	assert.Equal(t, len(fields), 3)
+	assert.Equal(t, fields[0].Key, "ID")
+	assert.Equal(t, Basic(fields[0].Type), "int64")
+	assert.True(t, IsNamed(fields[0].Type))
+	assert.Equal(t, fields[1].Key, "name")
+	assert.Equal(t, Basic(fields[1].Type), "string")
+	assert.Equal(t, fields[2].Key, "b")
+	assert.Equal(t, Basic(fields[2].Type), "uint8")
+}
+
+// Ensures that each name of a multi-name field declaration is its own field.
+func TestNewFileMultiName(t *testing.T) {
+	file := parse(t, "package foo\ntype Point struct { X, Y, Z float64; Name, Label string `json:\",omitempty\"` }\n")
+	fields := file.Types[0].Fields
+	assert.Equal(t, len(fields), 5)
+	for i, name := range []string{"X", "Y", "Z", "Name", "Label"} {
+		assert.Equal(t, fields[i].Name, name)
+		assert.Equal(t, fields[i].Key, name)
+	}
+	assert.Equal(t, Basic(fields[2].Type), "float64")
+	assert.True(t, fields[3].OmitEmpty && fields[4].OmitEmpty)
+}
+
+// Ensures that embedded struct fields are promoted and conflicts are removed.
+func TestNewFileEmbedded(t *testing.T) {
+	file := parse(t, `
+package foo
+type A struct { X, Y int; Z int `+"`json:\"z\"`"+` }
+type B struct { X int; Z int `+"`json:\"z\"`"+` }
+type C struct { B; Z int }
+type Foo struct {
+    A
+    *C
+    Y string
+}
+`)
+	fields := file.Types[3].Fields
+	assert.Equal(t, len(fields), 4)
+	assert.Equal(t, fields[0].Name, "A.X")
+	assert.Equal(t, fields[1].Name, "A.Z")
+	assert.Equal(t, fields[1].Key, "z")
+	assert.Equal(t, fields[2].Name, "C.Z")
+	assert.Equal(t, fields[2].Key, "Z")
+	assert.Equal(t, len(fields[2].Embedded), 1)
+	assert.Equal(t, fields[2].Embedded[0].Name, "C")
+	assert.Equal(t, fields[3].Name, "Y")
+	assert.Equal(t, len(fields[3].Embedded), 0)
+}
+
+// Ensures that types declared in sibling files are resolved.
+func TestLoad(t *testing.T) {
+	path, _ := ioutil.TempDir("", "")
+	defer os.RemoveAll(path)
+	ioutil.WriteFile(filepath.Join(path, "a.go"), []byte("package foo\ntype A struct { B *B; ID ID }\n"), 0600)
+	ioutil.WriteFile(filepath.Join(path, "b.go"), []byte("package foo\ntype B struct {}\ntype ID uint\n"), 0600)
+
+	pkg, err := Load(token.NewFileSet(), path, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, len(pkg.Errors), 0)
+
+	f := pkg.File(filepath.Join(path, "a.go"))
+	assert.NotNil(t, f)
+	fields := pkg.NewFile(f).Types[0].Fields
+	assert.Equal(t, pkg.Struct(fields[0].Type.(*types.Pointer).Elem()).Name(), "B")
+	assert.Equal(t, Basic(fields[1].Type), "uint")
+}
+
+// Ensures that fields declared with the same key are reported.
+func TestNewFileConflict(t *testing.T) {
+	file := parse(t, `
+package foo
+type Foo struct {
+    A string `+"`json:\"name\"`"+`
+    B string `+"`json:\"name\"`"+`
+    Name string
+}
+`)
+	assert.Equal(t, len(file.Types[0].Fields), 1)
+	assert.Equal(t, len(file.Diagnostics), 1)
+	assert.Equal(t, file.Diagnostics[0].Error(), `foo.go:5:5: field B has the same JSON key "name" as field A`)
+}
+
+// Ensures that type errors in the declarations of types and in the imports
+// they use are reported while other type errors are not.
+func TestNewFileTypeErrors(t *testing.T) {
+	file := parse(t, `
+package foo
+import "example.com/missing"
+import unused "example.com/unused"
+type Foo struct {
+    A int
+    B Undefined
+    C []*missing.T
+}
+func f() { NewFooJSONEncoder(nil) }
+`)
+	assert.Equal(t, len(file.Diagnostics), 4)
+	assert.True(t, strings.HasPrefix(file.Diagnostics[0].Error(), "foo.go:3:8: could not import example.com/missing"))
+	assert.Equal(t, file.Diagnostics[1].Error(), "foo.go:7:7: undefined: Undefined")
+	assert.Equal(t, file.Diagnostics[2].Error(), "foo.go:7:5: field B has an invalid type")
+	assert.Equal(t, file.Diagnostics[3].Error(), "foo.go:8:5: field C has an invalid type")
+
+	// Invalid types implement every interface but are never marshalers.
+	pkg := file.Package
+	st := pkg.Types.Scope().Lookup("Foo").Type().Underlying().(*types.Struct)
+	assert.Equal(t, pkg.Kind(st.Field(1).Type()), "")
+	assert.Equal(t, pkg.Marshaler(st.Field(1).Type()), "")
+	assert.Equal(t, pkg.Kind(st.Field(2).Type()), "")
+	assert.True(t, len(file.Package.Errors) > 2)
+}
+
+// Ensures that named types are converted to their underlying types.
+func TestConvert(t *testing.T) {
+	file := parse(t, "package foo\ntype ID int\ntype P *Foo\ntype Foo struct { ID ID; P P; N int }\n")
+	pkg, fields := file.Package, file.Types[0].Fields
+	assert.Equal(t, pkg.Convert(fields[0].Type, "v"), "int(v)")
+	assert.Equal(t, pkg.Convert(fields[1].Type, "v"), "(*Foo)(v)")
+	assert.Equal(t, pkg.Convert(fields[2].Type, "v"), "v")
+	assert.Equal(t, pkg.ConvertPtr(fields[0].Type, "v"), "(*int)(v)")
+	assert.Equal(t, pkg.ConvertPtr(fields[2].Type, "v"), "v")
+}
+
+// Ensures that types with marshal methods and other types are detected.
+func TestKind(t *testing.T) {
+	file := parse(t, `
+package foo
+type Level int
+func (l Level) MarshalText() ([]byte, error) { return nil, nil }
+type Raw []byte
+func (r *Raw) UnmarshalJSON(b []byte) error { return nil }
+type Foo struct { L Level; R Raw; P *Raw; N int; S []string; F *Foo; B []byte; E interface{}; C chan int }
+`)
+	pkg, fields := file.Package, file.Types[0].Fields
+	assert.Equal(t, pkg.Kind(fields[0].Type), "marshaler")
+	assert.Equal(t, pkg.Marshaler(fields[0].Type), "text")
+	assert.Equal(t, pkg.Unmarshaler(fields[0].Type), "")
+	assert.Equal(t, pkg.Kind(fields[1].Type), "marshaler")
+	assert.Equal(t, pkg.Unmarshaler(fields[1].Type), "json")
+	assert.Equal(t, pkg.Kind(fields[2].Type), "marshaler")
+	assert.Equal(t, pkg.Unmarshaler(fields[2].Type), "json")
+	assert.Equal(t, pkg.Kind(fields[3].Type), "int")
+	assert.Equal(t, pkg.Kind(fields[4].Type), "slice")
+	assert.Equal(t, pkg.Kind(fields[5].Type), "*")
+	assert.Equal(t, pkg.Kind(fields[6].Type), "bytes")
+	assert.Equal(t, pkg.Kind(fields[7].Type), "interface")
+	assert.Equal(t, len(fields), 8)
+	assert.Equal(t, len(file.Diagnostics), 1)
+	assert.Equal(t, file.Diagnostics[0].Error(), "foo.go:7:95: field C has unsupported type chan int")
+}
+
+// Ensures that local structs with marshal methods use them unless the
+// methods were generated by megajson.
+func TestKindMarshalerStruct(t *testing.T) {
+	fset := token.NewFileSet()
+	var files []*ast.File
+	for i, src := range []string{
+		"package foo\ntype A struct { B B; C *C; D []D }\ntype B struct { X int }\nfunc (b B) MarshalJSON() ([]byte, error) { return nil, nil }\ntype C struct { X int }\nfunc (c *C) UnmarshalText(b []byte) error { return nil }\ntype D struct { X int }\n",
+		"// Code generated by megajson. DO NOT EDIT.\n\npackage foo\nfunc (d *D) MarshalJSON() ([]byte, error) { return nil, nil }\nfunc (a *A) UnmarshalJSON(b []byte) error { return nil }\n",
+	} {
+		f, err := parser.ParseFile(fset, fmt.Sprintf("foo%d.go", i), src, parser.ParseComments)
+		if err != nil {
+			t.Fatal(err)
+		}
+		files = append(files, f)
+	}
+	file := NewPackage(fset, files).NewFile(files[0])
+	pkg, typs := file.Package, file.Types
+	assert.Equal(t, len(typs), 2)
+	assert.Equal(t, typs[0].Name, "A")
+	assert.Equal(t, typs[1].Name, "D")
+	assert.Equal(t, pkg.Kind(typs[0].Fields[0].Type), "marshaler")
+	assert.Equal(t, pkg.Marshaler(typs[0].Fields[0].Type), "json")
+	assert.Equal(t, pkg.Kind(typs[0].Fields[1].Type), "marshaler")
+	assert.Equal(t, pkg.Unmarshaler(typs[0].Fields[1].Type), "text")
+	assert.Equal(t, pkg.Kind(typs[0].Fields[2].Type), "slice")
+	assert.Equal(t, pkg.Marshaler(pkg.Types.Scope().Lookup("D").Type()), "")
+}
+
+// Ensures that types are selected by directives and names.
+func TestSelect(t *testing.T) {
+	file := parse(t, `
+package foo
+//megajson:encode-only
+type A struct { B B; C *C }
+type B struct { X int }
+//megajson:skip
+type C struct { X int }
+//megajson:decode-only
+type D struct { X int }
+type E struct { X int }
+type ID int
+`)
+	pkg, typs := file.Package, file.Types
+	assert.Equal(t, len(typs), 3)
+	assert.Equal(t, typs[0].Name, "A")
+	assert.True(t, typs[0].Encode)
+	assert.False(t, typs[0].Decode)
+	assert.Equal(t, typs[1].Name, "B")
+	assert.True(t, typs[1].Encode)
+	assert.False(t, typs[1].Decode)
+	assert.Equal(t, typs[2].Name, "D")
+	assert.False(t, typs[2].Encode)
+	assert.True(t, typs[2].Decode)
+	assert.Equal(t, pkg.Kind(typs[0].Fields[1].Type), "value")
+
+	assert.NoError(t, pkg.Select([]string{"E", "C"}))
+	typs = pkg.NewFile(pkg.Files...).Types
+	assert.Equal(t, len(typs), 2)
+	assert.Equal(t, typs[0].Name, "C")
+	assert.Equal(t, typs[1].Name, "E")
+	assert.True(t, typs[1].Encode && typs[1].Decode)
+	assert.Error(t, pkg.Select([]string{"ID"}))
+}
+
+// parse type checks a single source file and returns its model.
+func parse(t *testing.T, src string) *File {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "foo.go", src, parser.ParseComments)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return NewPackage(fset, []*ast.File{f}).NewFile(f)
+}
@@ -0,0 +1,120 @@
+package model
+
+import (
+	"go/ast"
+	"go/build"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"path/filepath"
+)
+
+// generatedComment is the comment at the top of every file megajson writes.
+const generatedComment = "// Code generated by megajson. DO NOT EDIT."
+
+// Package represents a parsed and type checked Go package.
+type Package struct {
+	Fset   *token.FileSet
+	Files  []*ast.File
+	Types  *types.Package
+	Info   *types.Info
+	Errors []error
+
+	// selected holds the directions code is generated in for each struct type.
+	selected map[*types.TypeName]int
+
+	// generated holds the files which were written by megajson.
+	generated map[*token.File]bool
+}
+
+// Load parses and type checks the Go package in a directory. Internal test
+// files are included so that their types are available to the generator.
+// Files are selected using the default build context and any extra tags.
+func Load(fset *token.FileSet, dir string, tags []string) (*Package, error) {
+	ctxt := build.Default
+	ctxt.BuildTags = append(append([]string{}, ctxt.BuildTags...), tags...)
+	bp, err := ctxt.ImportDir(dir, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var files []*ast.File
+	for _, names := range [][]string{bp.GoFiles, bp.CgoFiles, bp.TestGoFiles} {
+		for _, name := range names {
+			file, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, parser.ParseComments)
+			if err != nil {
+				return nil, err
+			}
+			files = append(files, file)
+		}
+	}
+
+	return NewPackage(fset, files), nil
+}
+
+// NewPackage type checks a set of parsed files belonging to the same package.
+// Type errors do not stop the check so that generation can proceed on packages
+// which reference code that has not been generated yet. They are available
+// on the Errors field and the errors in the declarations of selected types
+// are reported as diagnostics by NewFile. Types are selected using their
+// directives.
+func NewPackage(fset *token.FileSet, files []*ast.File) *Package {
+	p := &Package{
+		Fset:  fset,
+		Files: files,
+		Info: &types.Info{
+			Types:     make(map[ast.Expr]types.TypeAndValue),
+			Defs:      make(map[*ast.Ident]types.Object),
+			Uses:      make(map[*ast.Ident]types.Object),
+			Implicits: make(map[ast.Node]types.Object),
+		},
+		generated: make(map[*token.File]bool),
+	}
+	for _, f := range files {
+		if isGenerated(f) {
+			p.generated[fset.File(f.Pos())] = true
+		}
+	}
+
+	var name string
+	if len(files) > 0 {
+		name = files[0].Name.Name
+	}
+
+	conf := types.Config{
+		Importer: importer.ForCompiler(fset, "source", nil),
+		Error:    func(err error) { p.Errors = append(p.Errors, err) },
+	}
+	p.Types, _ = conf.Check(name, fset, files, p.Info)
+	p.Select(nil)
+	return p
+}
+
+// isGenerated returns true if a file has the comment that megajson writes at
+// the top of the files it generates.
+func isGenerated(f *ast.File) bool {
+	for _, group := range f.Comments {
+		if group.Pos() >= f.Package {
+			break
+		}
+		for _, c := range group.List {
+			if c.Text == generatedComment {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// File returns the file in the package with the given path.
+// Returns nil if the file is not part of the package.
+func (p *Package) File(path string) *ast.File {
+	path = filepath.Clean(path)
+	for _, f := range p.Files {
+		if p.Fset.File(f.Pos()).Name() == path {
+			return f
+		}
+	}
+	return nil
+}
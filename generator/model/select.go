@@ -0,0 +1,158 @@
+package model
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strings"
+)
+
+// Directives which select the struct types that code is generated for.
+// They are written in the doc comment of a type declaration.
+const (
+	DirectiveGenerate   = "//megajson:generate"
+	DirectiveEncodeOnly = "//megajson:encode-only"
+	DirectiveDecodeOnly = "//megajson:decode-only"
+	DirectiveSkip       = "//megajson:skip"
+)
+
+// The directions that code is generated in for a type.
+const (
+	encode = 1 << iota
+	decode
+)
+
+// Select chooses the struct types in the package that encoders and decoders
+// are generated for. Once a type is named or marked with a generate,
+// encode-only or decode-only directive then only those types are selected.
+// Otherwise every struct type without a skip directive is selected. Names
+// which are not declared in the package are ignored.
+//
+// Struct types used by the fields of a selected type are selected as well so
+// that the generated code compiles. Skipped types are never selected and
+// are encoded and decoded with encoding/json instead. Types with their own
+// JSON or text marshaling methods are never selected either since those
+// methods are called instead.
+func (p *Package) Select(names []string) error {
+	directives := p.directives()
+	direction := func(obj *types.TypeName) int {
+		switch directives[obj] {
+		case DirectiveEncodeOnly:
+			return encode
+		case DirectiveDecodeOnly:
+			return decode
+		}
+		return encode | decode
+	}
+
+	// Find the types that are explicitly selected.
+	var seeds []*types.TypeName
+	optin := len(names) > 0
+	for _, directive := range directives {
+		if directive != DirectiveSkip {
+			optin = true
+		}
+	}
+	if len(names) > 0 {
+		for _, name := range names {
+			obj, _ := p.Types.Scope().Lookup(name).(*types.TypeName)
+			if obj == nil || p.isMarshaler(obj.Type()) {
+				continue
+			} else if p.structName(obj.Type()) == nil {
+				return fmt.Errorf("not a struct type: %s", name)
+			}
+			seeds = append(seeds, obj)
+		}
+	} else {
+		for _, name := range p.Types.Scope().Names() {
+			obj, _ := p.Types.Scope().Lookup(name).(*types.TypeName)
+			if obj == nil || p.structName(obj.Type()) == nil || p.isMarshaler(obj.Type()) {
+				continue
+			} else if directive := directives[obj]; directive == DirectiveSkip || (optin && directive == "") {
+				continue
+			}
+			seeds = append(seeds, obj)
+		}
+	}
+
+	// Add the struct types that selected types depend on.
+	p.selected = make(map[*types.TypeName]int)
+	seen := make(map[types.Type]int)
+	var visit func(types.Type, int)
+	visit = func(t types.Type, dir int) {
+		if seen[t]&dir == dir {
+			return
+		}
+		seen[t] |= dir
+
+		if p.isMarshaler(t) {
+			return
+		} else if obj := p.structName(t); obj != nil {
+			if directives[obj] == DirectiveSkip && p.selected[obj] == 0 {
+				return
+			}
+			p.selected[obj] |= dir
+			fields, _ := p.fields(obj.Type())
+			for _, field := range fields {
+				visit(field.Type, dir)
+			}
+			return
+		}
+
+		switch typ := t.Underlying().(type) {
+		case *types.Pointer:
+			visit(typ.Elem(), dir)
+		case *types.Slice:
+			visit(typ.Elem(), dir)
+		case *types.Array:
+			visit(typ.Elem(), dir)
+		case *types.Map:
+			visit(typ.Elem(), dir)
+		}
+	}
+	for _, obj := range seeds {
+		p.selected[obj] |= direction(obj)
+		visit(obj.Type(), direction(obj))
+	}
+	return nil
+}
+
+// isMarshaler returns true if a type has JSON or text marshaling methods.
+func (p *Package) isMarshaler(t types.Type) bool {
+	return p.Marshaler(t) != "" || p.Unmarshaler(t) != ""
+}
+
+// directives returns the megajson directive of each struct type declaration.
+func (p *Package) directives() map[*types.TypeName]string {
+	m := make(map[*types.TypeName]string)
+	for _, f := range p.Files {
+		for _, decl := range f.Decls {
+			decl, ok := decl.(*ast.GenDecl)
+			if !ok || decl.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range decl.Specs {
+				spec := spec.(*ast.TypeSpec)
+				doc := spec.Doc
+				if doc == nil && !decl.Lparen.IsValid() {
+					doc = decl.Doc
+				}
+				if doc == nil {
+					continue
+				}
+				obj, ok := p.Info.Defs[spec.Name].(*types.TypeName)
+				if !ok {
+					continue
+				}
+				for _, c := range doc.List {
+					switch text := strings.TrimSpace(c.Text); text {
+					case DirectiveGenerate, DirectiveEncodeOnly, DirectiveDecodeOnly, DirectiveSkip:
+						m[obj] = text
+					}
+				}
+			}
+		}
+	}
+	return m
+}
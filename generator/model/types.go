@@ -0,0 +1,336 @@
+package model
+
+import (
+	"go/types"
+	"strings"
+)
+
+// Basic returns the name of the basic type underlying a type. Aliases such
+// as byte and rune are returned by their canonical names. Returns a blank
+// string if the type is not a basic type.
+func Basic(t types.Type) string {
+	if b, ok := t.Underlying().(*types.Basic); ok {
+		return types.Typ[b.Kind()].Name()
+	}
+	return ""
+}
+
+// IsNamed returns true if a type is a defined type instead of a type literal.
+func IsNamed(t types.Type) bool {
+	_, ok := types.Unalias(t).(*types.Named)
+	return ok
+}
+
+// Kind returns the name of the encoding used for a type. Primitive types
+// return the name of their underlying basic type. Structs declared in the
+// package return "struct", pointers to them return "*" and slices of those
+// pointers return "[]". Other slices and arrays return "slice" if their
+// elements are supported and byte slices return "bytes". Maps return "map"
+// or "map[string]interface{}" if they can be read and written directly by
+// the scanner and writer. Types with JSON or text marshaling methods return
+// "marshaler" and empty interfaces return "interface". All other types which
+// encoding/json can handle return "value". Returns a blank string if the
+// type is not supported or is invalid.
+func (p *Package) Kind(t types.Type) string {
+	if !isValid(t) {
+		return ""
+	} else if p.isMarshaler(t) {
+		return "marshaler"
+	}
+
+	switch typ := t.Underlying().(type) {
+	case *types.Pointer:
+		if p.Struct(typ.Elem()) != nil {
+			return "*"
+		}
+	case *types.Slice:
+		if ptr, ok := types.Unalias(typ.Elem()).(*types.Pointer); ok && p.Struct(ptr.Elem()) != nil {
+			return "[]"
+		}
+	}
+
+	if p.Struct(t) != nil {
+		return "struct"
+	}
+
+	switch typ := t.Underlying().(type) {
+	case *types.Basic:
+		switch name := Basic(typ); name {
+		case "string", "bool", "float32", "float64",
+			"int", "int8", "int16", "int32", "int64",
+			"uint", "uint8", "uint16", "uint32", "uint64", "uintptr":
+			return name
+		}
+		return ""
+	case *types.Slice:
+		// Byte slices are encoded as base64 strings by encoding/json.
+		if types.Identical(typ.Elem(), types.Typ[types.Uint8]) {
+			return "bytes"
+		} else if Basic(typ.Elem()) == "uint8" && p.Kind(typ.Elem()) != "marshaler" {
+			return "value"
+		} else if p.Kind(typ.Elem()) != "" {
+			return "slice"
+		}
+		return ""
+	case *types.Array:
+		if p.Kind(typ.Elem()) != "" {
+			return "slice"
+		}
+		return ""
+	case *types.Map:
+		if types.Identical(typ.Key(), types.Typ[types.String]) && isEmptyInterface(typ.Elem()) {
+			return "map[string]interface{}"
+		}
+		switch p.Kind(typ.Key()) {
+		case "string", "int", "int8", "int16", "int32", "int64",
+			"uint", "uint8", "uint16", "uint32", "uint64", "uintptr":
+			if p.Kind(typ.Elem()) != "" {
+				return "map"
+			}
+		}
+	case *types.Interface:
+		if typ.Empty() {
+			return "interface"
+		}
+	case *types.Chan, *types.Signature:
+		return ""
+	}
+	return "value"
+}
+
+// Marshaler returns "json" or "text" if a type is encoded by calling its
+// MarshalJSON or MarshalText method. Pointer and interface values must be
+// checked for nil before calling the method. Returns a blank string if the
+// type has neither method.
+func (p *Package) Marshaler(t types.Type) string {
+	switch {
+	case p.implements(t, marshalerType):
+		return "json"
+	case p.implements(t, textMarshalerType):
+		return "text"
+	}
+	return ""
+}
+
+// Unmarshaler returns "json" or "text" if a type is decoded by calling its
+// UnmarshalJSON or UnmarshalText method. Pointer values must be allocated
+// before calling the method. Returns a blank string if the type has neither
+// method.
+func (p *Package) Unmarshaler(t types.Type) string {
+	if _, ok := t.Underlying().(*types.Interface); ok {
+		return ""
+	}
+	switch {
+	case p.implements(t, unmarshalerType):
+		return "json"
+	case p.implements(t, textUnmarshalerType):
+		return "text"
+	}
+	return ""
+}
+
+// IsSlice returns true if a type is a slice.
+func IsSlice(t types.Type) bool {
+	_, ok := t.Underlying().(*types.Slice)
+	return ok
+}
+
+// IsNillable returns true if a type is a pointer or an interface.
+func IsNillable(t types.Type) bool {
+	switch t.Underlying().(type) {
+	case *types.Pointer, *types.Interface:
+		return true
+	}
+	return false
+}
+
+var (
+	byteSliceType = types.NewSlice(types.Typ[types.Byte])
+	errorType     = types.Universe.Lookup("error").Type()
+
+	marshalerType       = newInterface("MarshalJSON", nil, []types.Type{byteSliceType, errorType})
+	unmarshalerType     = newInterface("UnmarshalJSON", []types.Type{byteSliceType}, []types.Type{errorType})
+	textMarshalerType   = newInterface("MarshalText", nil, []types.Type{byteSliceType, errorType})
+	textUnmarshalerType = newInterface("UnmarshalText", []types.Type{byteSliceType}, []types.Type{errorType})
+)
+
+// newInterface returns an interface type with a single method.
+func newInterface(name string, params, results []types.Type) *types.Interface {
+	vars := func(typs []types.Type) *types.Tuple {
+		var v []*types.Var
+		for _, typ := range typs {
+			v = append(v, types.NewParam(0, nil, "", typ))
+		}
+		return types.NewTuple(v...)
+	}
+	sig := types.NewSignatureType(nil, nil, nil, vars(params), vars(results), false)
+	return types.NewInterfaceType([]*types.Func{types.NewFunc(0, nil, name, sig)}, nil).Complete()
+}
+
+// implements returns true if a type has the method of a single method
+// interface. The methods of non-pointer types are looked up on a pointer to
+// the type since generated code always calls them on addressable values.
+// Defined pointer types have no methods. Methods declared in files that
+// megajson generated are ignored so that generated MarshalJSON and
+// UnmarshalJSON methods do not change the code that is generated.
+func (p *Package) implements(t types.Type, iface *types.Interface) bool {
+	// Invalid types implement every interface.
+	if !isValid(t) {
+		return false
+	}
+
+	recv := t
+	switch t.Underlying().(type) {
+	case *types.Pointer:
+		if IsNamed(t) {
+			return false
+		}
+	case *types.Interface:
+	default:
+		recv = types.NewPointer(t)
+	}
+	if !types.Implements(recv, iface) {
+		return false
+	}
+	obj, _, _ := types.LookupFieldOrMethod(recv, true, p.Types, iface.Method(0).Name())
+	return obj == nil || !p.generated[p.Fset.File(obj.Pos())]
+}
+
+// MethodName returns the name used by the scanner and writer methods which
+// read and write a primitive kind, such as "Int64" for "int64".
+func MethodName(kind string) string {
+	if kind == "" {
+		return ""
+	}
+	return strings.ToUpper(kind[:1]) + kind[1:]
+}
+
+// isValid returns false if a type or the type of any of its elements is
+// invalid. Types which fail to type check, such as types from imports that
+// cannot be resolved, are invalid.
+func isValid(t types.Type) bool {
+	switch typ := types.Unalias(t).(type) {
+	case *types.Basic:
+		return typ.Kind() != types.Invalid
+	case *types.Named:
+		b, ok := typ.Underlying().(*types.Basic)
+		return !ok || b.Kind() != types.Invalid
+	case *types.Pointer:
+		return isValid(typ.Elem())
+	case *types.Slice:
+		return isValid(typ.Elem())
+	case *types.Array:
+		return isValid(typ.Elem())
+	case *types.Map:
+		return isValid(typ.Key()) && isValid(typ.Elem())
+	}
+	return true
+}
+
+// isEmptyInterface returns true if a type is an interface without methods.
+func isEmptyInterface(t types.Type) bool {
+	typ, ok := t.Underlying().(*types.Interface)
+	return ok && typ.Empty()
+}
+
+// Struct returns the named struct type that a type refers to if it is
+// declared in the package and code is generated for it. Returns nil for all
+// other types.
+func (p *Package) Struct(t types.Type) *types.TypeName {
+	if obj := p.structName(t); obj != nil && p.selected[obj] != 0 {
+		return obj
+	}
+	return nil
+}
+
+// structName returns the named struct type that a type refers to if it is
+// declared in the package.
+func (p *Package) structName(t types.Type) *types.TypeName {
+	named, ok := types.Unalias(t).(*types.Named)
+	if !ok || named.Obj().Pkg() != p.Types || named.TypeArgs() != nil {
+		return nil
+	} else if _, ok := named.Underlying().(*types.Struct); !ok {
+		return nil
+	}
+	return named.Obj()
+}
+
+// TypeString returns the Go source representation of a type relative to
+// the package.
+func (p *Package) TypeString(t types.Type) string {
+	return types.TypeString(t, func(other *types.Package) string {
+		if other == p.Types {
+			return ""
+		}
+		return other.Name()
+	})
+}
+
+// Elem returns the element type of a pointer, slice, array or map type.
+func Elem(t types.Type) types.Type {
+	switch typ := t.Underlying().(type) {
+	case *types.Pointer:
+		return typ.Elem()
+	case *types.Slice:
+		return typ.Elem()
+	case *types.Array:
+		return typ.Elem()
+	case *types.Map:
+		return typ.Elem()
+	}
+	return nil
+}
+
+// Key returns the key type of a map type.
+func Key(t types.Type) types.Type {
+	if typ, ok := t.Underlying().(*types.Map); ok {
+		return typ.Key()
+	}
+	return nil
+}
+
+// BitSize returns the size, in bits, of an integer type as used by the
+// strconv package. Platform dependent sizes return zero.
+func BitSize(t types.Type) int {
+	switch Basic(t) {
+	case "int8", "uint8":
+		return 8
+	case "int16", "uint16":
+		return 16
+	case "int32", "uint32", "float32":
+		return 32
+	case "int64", "uint64", "float64":
+		return 64
+	}
+	return 0
+}
+
+// IsUnsigned returns true if a type is an unsigned integer type.
+func IsUnsigned(t types.Type) bool {
+	if b, ok := t.Underlying().(*types.Basic); ok {
+		return b.Info()&types.IsUnsigned != 0
+	}
+	return false
+}
+
+// Convert returns an expression converting expr to the underlying type of t.
+// The expression is returned unchanged when t is not a defined type.
+func (p *Package) Convert(t types.Type, expr string) string {
+	if !IsNamed(t) {
+		return expr
+	}
+	typ := p.TypeString(t.Underlying())
+	if strings.HasPrefix(typ, "*") {
+		typ = "(" + typ + ")"
+	}
+	return typ + "(" + expr + ")"
+}
+
+// ConvertPtr returns an expression converting expr, a pointer to t, to a
+// pointer to the underlying type of t.
+func (p *Package) ConvertPtr(t types.Type, expr string) string {
+	if !IsNamed(t) {
+		return expr
+	}
+	return "(*" + p.TypeString(t.Underlying()) + ")(" + expr + ")"
+}
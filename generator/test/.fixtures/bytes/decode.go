@@ -0,0 +1,26 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"strings"
+)
+
+const DATA = `{"Data":"aGVsbG8gd29ybGQ=","Nil":null,"Empty":"","Blob":"AAH+/w==","Fixed":[1,2,3],"Chunks":["Zm9v",null],"Omit":"YmFy"}`
+
+func main() {
+	var obj *A
+	d := NewAJSONDecoder(strings.NewReader(DATA))
+	if err := d.Decode(&obj); err != nil {
+		log.Fatalln("Decoding error: ", err.Error())
+	}
+
+	fmt.Print("|")
+	fmt.Printf("%s|", obj.Data)
+	fmt.Printf("%v|", obj.Nil == nil)
+	fmt.Printf("%v|", obj.Empty != nil && len(obj.Empty) == 0)
+	fmt.Printf("%v|", obj.Blob)
+	fmt.Printf("%v|", obj.Fixed)
+	fmt.Printf("%q|", obj.Chunks)
+	fmt.Printf("%s|", obj.Omit)
+}
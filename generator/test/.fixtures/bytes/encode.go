@@ -0,0 +1,20 @@
+package main
+
+import (
+	"log"
+	"os"
+)
+
+func main() {
+	obj := &A{
+		Data: []byte("hello world"),
+		Empty: []byte{},
+		Blob: Blob{0, 1, 254, 255},
+		Fixed: [3]byte{1, 2, 3},
+		Chunks: [][]byte{[]byte("foo"), nil},
+	}
+	e := NewAJSONEncoder(os.Stdout)
+	if err := e.Encode(obj); err != nil {
+		log.Fatalln("Encoding error: ", err.Error())
+	}
+}
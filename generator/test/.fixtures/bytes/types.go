@@ -0,0 +1,13 @@
+package main
+
+type A struct {
+    Data []byte
+    Nil []byte
+    Empty []byte
+    Blob Blob
+    Fixed [3]byte
+    Chunks [][]byte
+    Omit []byte `json:",omitempty"`
+}
+
+type Blob []byte
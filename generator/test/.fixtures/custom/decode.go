@@ -0,0 +1,26 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"strings"
+)
+
+const DATA = `{"Value":"custom-1","Ptr":"custom-2","Nil":null,"Map":{"a":"custom-3"},"Slice":["custom-4"],"Ptrs":["custom-5",null],"Label":"FOO"}`
+
+func main() {
+	var obj *A
+	d := NewAJSONDecoder(strings.NewReader(DATA))
+	if err := d.Decode(&obj); err != nil {
+		log.Fatalln("Decoding error: ", err.Error())
+	}
+
+	fmt.Print("|")
+	fmt.Printf("%v|", obj.Value.A)
+	fmt.Printf("%v|", obj.Ptr.A)
+	fmt.Printf("%v|", obj.Nil)
+	fmt.Printf("%v|", obj.Map["a"].A)
+	fmt.Printf("%v|", obj.Slice[0].A)
+	fmt.Printf("%v|%v|", obj.Ptrs[0].A, obj.Ptrs[1])
+	fmt.Printf("%v|", obj.Label.Text)
+}
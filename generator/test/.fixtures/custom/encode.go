@@ -0,0 +1,22 @@
+package main
+
+import (
+	"log"
+	"os"
+)
+
+func main() {
+	obj := &A{
+		Value: Custom{A: 1},
+		Ptr: &Custom{A: 2},
+		Map: map[string]Custom{"a": {A: 3}},
+		Slice: []Custom{{A: 4}},
+		Ptrs: []*Custom{{A: 5}, nil},
+		Label: Label{Text: "foo"},
+		Extra: Custom{A: 6},
+	}
+	e := NewAJSONEncoder(os.Stdout)
+	if err := e.Encode(obj); err != nil {
+		log.Fatalln("Encoding error: ", err.Error())
+	}
+}
@@ -0,0 +1,43 @@
+package main
+
+import (
+    "fmt"
+    "strings"
+)
+
+type A struct {
+    Value Custom
+    Ptr *Custom
+    Nil *Custom
+    Map map[string]Custom
+    Slice []Custom
+    Ptrs []*Custom
+    Label Label
+    Extra interface{}
+}
+
+type Custom struct {
+    A int
+}
+
+func (c Custom) MarshalJSON() ([]byte, error) {
+    return []byte(fmt.Sprintf(`"custom-%d"`, c.A)), nil
+}
+
+func (c *Custom) UnmarshalJSON(b []byte) error {
+    _, err := fmt.Sscanf(string(b), `"custom-%d"`, &c.A)
+    return err
+}
+
+type Label struct {
+    Text string
+}
+
+func (l Label) MarshalText() ([]byte, error) {
+    return []byte(strings.ToUpper(l.Text)), nil
+}
+
+func (l *Label) UnmarshalText(b []byte) error {
+    l.Text = strings.ToLower(string(b))
+    return nil
+}
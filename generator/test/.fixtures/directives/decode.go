@@ -0,0 +1,26 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"strings"
+)
+
+const DATA = `{"Name":"foo","B":{"ID":1},"H":{"X":2}}`
+
+func main() {
+	obj := &A{}
+	if err := NewAJSONDecoder(strings.NewReader(DATA)).Decode(&obj); err != nil {
+		log.Fatalln("Decoding error: ", err.Error())
+	}
+	d := &D{}
+	if err := NewDJSONDecoder(strings.NewReader(`{"Z":4}`)).Decode(&d); err != nil {
+		log.Fatalln("Decoding error: ", err.Error())
+	}
+
+	fmt.Print("|")
+	fmt.Printf("%v|", obj.Name)
+	fmt.Printf("%v|", *obj.B)
+	fmt.Printf("%v|", obj.H.X)
+	fmt.Printf("%v|", d.Z)
+}
@@ -0,0 +1,16 @@
+package main
+
+import (
+	"log"
+	"os"
+)
+
+func main() {
+	obj := &A{Name: "foo", B: &B{ID: 1}, H: helper{X: 2}}
+	if err := NewAJSONEncoder(os.Stdout).Encode(obj); err != nil {
+		log.Fatalln("Encoding error: ", err.Error())
+	}
+	if err := NewEJSONEncoder(os.Stdout).Encode(&E{Y: 3}); err != nil {
+		log.Fatalln("Encoding error: ", err.Error())
+	}
+}
@@ -0,0 +1,35 @@
+package main
+
+//megajson:generate
+type A struct {
+	Name string
+	B    *B
+	H    helper
+}
+
+type B struct {
+	ID int
+}
+
+// helper is encoded with encoding/json.
+//
+//megajson:skip
+type helper struct {
+	X int
+	c chan int
+}
+
+//megajson:encode-only
+type E struct {
+	Y int
+}
+
+//megajson:decode-only
+type D struct {
+	Z int
+}
+
+// Unused is not generated.
+type Unused struct {
+	F func()
+}
@@ -0,0 +1,25 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"strings"
+)
+
+const DATA = `{"Extra":{"a":[1,"x",null]},"Payload":1.5,"Nil":null,"Ptr":{"ID":1},"List":[true,"s",3,[]],"Named":"named"}`
+
+func main() {
+	obj := &A{Nil: "foo"}
+	d := NewAJSONDecoder(strings.NewReader(DATA))
+	if err := d.Decode(&obj); err != nil {
+		log.Fatalln("Decoding error: ", err.Error())
+	}
+
+	fmt.Print("|")
+	fmt.Printf("%#v|", obj.Extra)
+	fmt.Printf("%#v|", obj.Payload)
+	fmt.Printf("%#v|", obj.Nil)
+	fmt.Printf("%#v|", obj.Ptr)
+	fmt.Printf("%#v|", obj.List)
+	fmt.Printf("%#v|", obj.Named)
+}
@@ -0,0 +1,21 @@
+package main
+
+import (
+	"log"
+	"os"
+)
+
+func main() {
+	obj := &A{
+		Extra: map[string]interface{}{"a": []interface{}{1, "x", nil}},
+		Payload: 1.5,
+		Ptr: &B{ID: 1, hidden: "h"},
+		Value: B{ID: 2, hidden: "i"},
+		List: []interface{}{true, "s", int8(3), []string{"z"}},
+		Named: "named",
+	}
+	e := NewAJSONEncoder(os.Stdout)
+	if err := e.Encode(obj); err != nil {
+		log.Fatalln("Encoding error: ", err.Error())
+	}
+}
@@ -0,0 +1,18 @@
+package main
+
+type A struct {
+    Extra interface{}
+    Payload any
+    Nil interface{}
+    Ptr interface{}
+    Value interface{}
+    List []interface{}
+    Named Any
+}
+
+type Any interface{}
+
+type B struct {
+    ID int
+    hidden string
+}
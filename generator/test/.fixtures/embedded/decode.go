@@ -0,0 +1,26 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"strings"
+)
+
+const DATA = `{"id":1,"name":"foo","created":10,"Name":"audit"}`
+
+func main() {
+	var obj *A
+	d := NewAJSONDecoder(strings.NewReader(DATA))
+	if err := d.Decode(&obj); err != nil {
+		log.Fatalln("Decoding error: ", err.Error())
+	}
+
+	fmt.Print("|")
+	fmt.Printf("%v|", obj.ID)
+	fmt.Printf("%v|", obj.Name)
+	fmt.Printf("%v|", obj.Metadata.Name)
+	fmt.Printf("%v|", obj.Created)
+	fmt.Printf("%v|", obj.Audit.Name)
+	fmt.Printf("%v|", obj.Audit.By)
+	fmt.Printf("%v|", obj.Other.By)
+}
@@ -0,0 +1,24 @@
+package main
+
+import (
+	"log"
+	"os"
+)
+
+func main() {
+	a := &A{
+		Metadata: Metadata{ID: 1, Name: "hidden"},
+		Timestamps: &Timestamps{Created: 10},
+		Audit: Audit{Name: "audit", By: "john"},
+		Other: Other{By: "jane"},
+		Name: "foo",
+	}
+	if err := NewAJSONEncoder(os.Stdout).Encode(a); err != nil {
+		log.Fatalln("Encoding error: ", err.Error())
+	}
+
+	b := &B{Name: "bar"}
+	if err := NewBJSONEncoder(os.Stdout).Encode(b); err != nil {
+		log.Fatalln("Encoding error: ", err.Error())
+	}
+}
@@ -0,0 +1,33 @@
+package main
+
+type Metadata struct {
+    ID int64 `json:"id"`
+    Name string `json:"name"`
+}
+
+type Timestamps struct {
+    Created int64 `json:"created"`
+    Updated int64 `json:"updated,omitempty"`
+}
+
+type Audit struct {
+    Name string
+    By string `json:"by"`
+}
+
+type Other struct {
+    By string `json:"by"`
+}
+
+type A struct {
+    Metadata
+    *Timestamps
+    Audit
+    Other
+    Name string `json:"name"`
+}
+
+type B struct {
+    *Timestamps
+    Name string
+}
@@ -0,0 +1,23 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"strings"
+)
+
+const DATA = `{"NAME":"foo","EMAIL":"x","alt":"exact","Alt":"folded"}`
+
+func main() {
+	obj := &A{}
+	d := NewAJSONDecoder(strings.NewReader(DATA))
+	if err := d.Decode(&obj); err != nil {
+		log.Fatalln("Decoding error: ", err.Error())
+	}
+
+	fmt.Print("|")
+	fmt.Printf("%v|", obj.Name)
+	fmt.Printf("%v|", obj.Email)
+	fmt.Printf("%v|", obj.Alt)
+	fmt.Printf("%v|", obj.Alt2)
+}
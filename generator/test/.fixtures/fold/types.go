@@ -0,0 +1,8 @@
+package main
+
+type A struct {
+	Name  string
+	Email string `json:"email"`
+	Alt   string `json:"ALT"`
+	Alt2  string `json:"alt"`
+}
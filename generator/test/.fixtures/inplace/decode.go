@@ -0,0 +1,22 @@
+package main
+
+import (
+	"fmt"
+)
+
+func main() {
+	var d AJSONDecoder
+	for _, data := range []string{
+		`{"Name":"foo","Count":"12","Tags":["a","b\n"],"B":{"Note":"été"},"Score":1.5e+2}`,
+		`{"Name":"bar"}`,
+		`{"Name":"baz",}`,
+	} {
+		obj := &A{}
+		err := d.DecodeBytes([]byte(data), &obj)
+		fmt.Printf("%s|%d|%q|", obj.Name, obj.Count, obj.Tags)
+		if obj.B != nil {
+			fmt.Printf("%s|", obj.B.Note)
+		}
+		fmt.Printf("%v|%v|", obj.Score, err)
+	}
+}
@@ -0,0 +1,13 @@
+package main
+
+type A struct {
+	Name  string
+	Count int    `json:",string"`
+	Tags  []string
+	B     *B
+	Score float64
+}
+
+type B struct {
+	Note string
+}
@@ -0,0 +1,28 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"strings"
+)
+
+const DATA = `{"Time":"2014-05-01T12:30:00Z","TimePtr":"2014-05-01T12:30:00Z","NilTime":null,"IP":"127.0.0.1","Big":12345678901234,"Level":"high","Levels":{"a":"low"},"Sizes":[3,4],"Extra":{"foo":[1,"bar"]}}`
+
+func main() {
+	var obj *A
+	d := NewAJSONDecoder(strings.NewReader(DATA))
+	if err := d.Decode(&obj); err != nil {
+		log.Fatalln("Decoding error: ", err.Error())
+	}
+
+	fmt.Print("|")
+	fmt.Printf("%v|", obj.Time.Unix())
+	fmt.Printf("%v|", obj.TimePtr.Unix())
+	fmt.Printf("%v|", obj.NilTime)
+	fmt.Printf("%v|", obj.IP)
+	fmt.Printf("%v|", obj.Big)
+	fmt.Printf("%v|", obj.Level)
+	fmt.Printf("%v|", obj.Levels["a"])
+	fmt.Printf("%v|", obj.Sizes)
+	fmt.Printf("%v|", obj.Extra)
+}
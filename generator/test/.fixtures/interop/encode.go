@@ -0,0 +1,27 @@
+package main
+
+import (
+	"log"
+	"math/big"
+	"net"
+	"os"
+	"time"
+)
+
+func main() {
+	t := time.Date(2014, time.May, 1, 12, 30, 0, 0, time.UTC)
+	obj := &A{
+		Time: t,
+		TimePtr: &t,
+		IP: net.IPv4(127, 0, 0, 1),
+		Big: big.NewInt(12345678901234),
+		Level: 1,
+		Levels: map[string]Level{"a": 0},
+		Sizes: [2]int{3, 4},
+		Extra: []string{"foo"},
+	}
+	e := NewAJSONEncoder(os.Stdout)
+	if err := e.Encode(obj); err != nil {
+		log.Fatalln("Encoding error: ", err.Error())
+	}
+}
@@ -0,0 +1,44 @@
+package main
+
+import (
+    "errors"
+    "math/big"
+    "net"
+    "time"
+)
+
+type A struct {
+    Time time.Time
+    TimePtr *time.Time
+    NilTime *time.Time
+    IP net.IP
+    Big *big.Int
+    Level Level
+    Levels map[string]Level
+    Sizes [2]int
+    Extra interface{}
+}
+
+type Level int
+
+func (l Level) MarshalText() ([]byte, error) {
+    switch l {
+    case 0:
+        return []byte("low"), nil
+    case 1:
+        return []byte("high"), nil
+    }
+    return nil, errors.New("invalid level")
+}
+
+func (l *Level) UnmarshalText(b []byte) error {
+    switch string(b) {
+    case "low":
+        *l = 0
+    case "high":
+        *l = 1
+    default:
+        return errors.New("invalid level")
+    }
+    return nil
+}
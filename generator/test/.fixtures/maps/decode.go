@@ -0,0 +1,29 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"strings"
+)
+
+const DATA = `{"Tags":{"foo":"bar","baz":"bat"},"Counts":{"x":10,"y":-20},"Bs":{"john":{"Name":"John"},"nil":null},"ByID":{"10":{"Name":"Jane"}},"Nested":{"a":{"1":1.5}},"Attrs":{"admin":true},"Extra":{"foo":"bar"},"Nil":null}`
+
+func main() {
+	var obj *A
+	d := NewAJSONDecoder(strings.NewReader(DATA))
+	if err := d.Decode(&obj); err != nil {
+		log.Fatalln("Decoding error: ", err.Error())
+	}
+
+	fmt.Print("|")
+	fmt.Printf("%v|", obj.Tags["foo"])
+	fmt.Printf("%v|", obj.Tags["baz"])
+	fmt.Printf("%v|", obj.Counts["y"])
+	fmt.Printf("%v|", obj.Bs["john"].Name)
+	fmt.Printf("%v|", obj.Bs["nil"])
+	fmt.Printf("%v|", obj.ByID[10].Name)
+	fmt.Printf("%v|", obj.Nested["a"][1])
+	fmt.Printf("%v|", obj.Attrs["admin"])
+	fmt.Printf("%v|", obj.Extra["foo"])
+	fmt.Printf("%v|", obj.Nil == nil)
+}
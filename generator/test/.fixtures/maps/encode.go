@@ -0,0 +1,27 @@
+package main
+
+import (
+	"log"
+	"os"
+)
+
+func main() {
+	obj := &A{
+		Tags: map[string]string{"foo": "bar", "baz": "bat"},
+		Counts: map[string]int64{"x": 10, "y": -20},
+		Bs: map[string]*B{"john": &B{Name: "John"}, "nil": nil},
+		ByID: map[int]*B{10: &B{Name: "Jane"}, 9: &B{Name: "Jack"}},
+		Nested: map[string]map[uint64]float64{"a": {1: 1.5}},
+		Attrs: Attrs{"admin": true},
+		Extra: map[string]interface{}{
+			"foo": "bar",
+			"a": []interface{}{"x", map[string]interface{}{"z": 1, "b": 2}},
+			"m": map[string]interface{}{"y": true, "c": nil},
+		},
+		Any: map[string]interface{}{"z": 1, "a": []interface{}{"x"}},
+	}
+	e := NewAJSONEncoder(os.Stdout)
+	if err := e.Encode(obj); err != nil {
+		log.Fatalln("Encoding error: ", err.Error())
+	}
+}
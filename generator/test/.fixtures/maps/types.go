@@ -0,0 +1,21 @@
+package main
+
+type Name string
+
+type Attrs map[Name]bool
+
+type A struct {
+    Tags map[string]string
+    Counts map[string]int64
+    Bs map[string]*B
+    ByID map[int]*B
+    Nested map[string]map[uint64]float64
+    Attrs Attrs
+    Extra map[string]interface{}
+    Any interface{}
+    Nil map[string]string
+}
+
+type B struct {
+    Name string
+}
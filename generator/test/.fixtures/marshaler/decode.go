@@ -0,0 +1,21 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+)
+
+const DATA = `{"Name":"foo","B":{"ID":1},"count":3}`
+
+func main() {
+	var obj A
+	if err := json.Unmarshal([]byte(DATA), &obj); err != nil {
+		log.Fatalln("Unmarshal error: ", err.Error())
+	}
+
+	fmt.Print("|")
+	fmt.Printf("%v|", obj.Name)
+	fmt.Printf("%v|", obj.B.ID)
+	fmt.Printf("%v|", obj.Count)
+}
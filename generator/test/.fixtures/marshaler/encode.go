@@ -0,0 +1,19 @@
+package main
+
+import (
+	"encoding/json"
+	"log"
+	"os"
+)
+
+func main() {
+	obj := &A{Name: "foo", B: &B{ID: 1}, Count: 3}
+	b, err := json.Marshal(obj)
+	if err != nil {
+		log.Fatalln("Marshal error: ", err.Error())
+	}
+	if b, err = obj.AppendJSON(append(b, '|')); err != nil {
+		log.Fatalln("Append error: ", err.Error())
+	}
+	os.Stdout.Write(b)
+}
@@ -0,0 +1,11 @@
+package main
+
+type A struct {
+    Name string
+    B *B
+    Count int64 `json:"count"`
+}
+
+type B struct {
+    ID int
+}
@@ -0,0 +1,27 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+func main() {
+	for _, data := range []string{
+		`{"Name":"foo","Count":12345,"Tags":["a","b"]}`,
+		`{"Name":"foo","Count":123456789,"Tags":["a","b"]}`,
+		`{"Name":"foo","Count":12,"Tags":["a","` + strings.Repeat("b", 100000) + `"]}`,
+		`{"Name":"foo","Other":` + strings.Repeat("[", 5000000),
+	} {
+		obj := &A{}
+		d := NewAJSONDecoder(strings.NewReader(data))
+		d.MaxTokenSize(8)
+		err := d.Decode(&obj)
+		fmt.Printf("%s|%d|%d|%v|", obj.Name, obj.Count, len(obj.Tags), err)
+	}
+
+	var d AJSONDecoder
+	d.MaxTokenSize(8)
+	obj := &A{}
+	err := d.DecodeBytes([]byte(`{"Name":"foobarbaz"}`), &obj)
+	fmt.Printf("%s|%v|", obj.Name, err)
+}
@@ -0,0 +1,7 @@
+package main
+
+type A struct {
+	Name  string
+	Count int
+	Tags  []string
+}
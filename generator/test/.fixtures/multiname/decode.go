@@ -0,0 +1,24 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"strings"
+)
+
+const DATA = `{"X":1.5,"Y":-2,"Z":3.25,"Name":"foo","Label":"bar"}`
+
+func main() {
+	var p *Point
+	d := NewPointJSONDecoder(strings.NewReader(DATA))
+	if err := d.Decode(&p); err != nil {
+		log.Fatalln("Point decoding error: ", err.Error())
+	}
+
+	fmt.Print("|")
+	fmt.Printf("%v|", p.X)
+	fmt.Printf("%v|", p.Y)
+	fmt.Printf("%v|", p.Z)
+	fmt.Printf("%v|", p.Name)
+	fmt.Printf("%v|", p.Label)
+}
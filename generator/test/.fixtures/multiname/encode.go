@@ -0,0 +1,14 @@
+package main
+
+import (
+	"log"
+	"os"
+)
+
+func main() {
+	p := &Point{X: 1.5, Y: -2, Z: 3.25, Name: "foo", Label: "bar"}
+	e := NewPointJSONEncoder(os.Stdout)
+	if err := e.Encode(p); err != nil {
+		log.Fatalln("Point encoding error: ", err.Error())
+	}
+}
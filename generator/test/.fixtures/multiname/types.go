@@ -0,0 +1,6 @@
+package main
+
+type Point struct {
+    X, Y, Z float64
+    Name, Label string
+}
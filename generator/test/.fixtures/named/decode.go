@@ -0,0 +1,25 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"strings"
+)
+
+const DATA = `{"ID":100,"Name":"John","Timeout":2000000000,"Friends":[{"ID":200,"Name":"Jane","Timeout":0,"Friends":[]}]}`
+
+func main() {
+	var u *User
+	d := NewUserJSONDecoder(strings.NewReader(DATA))
+	if err := d.Decode(&u); err != nil {
+		log.Fatalln("User decoding error: ", err.Error())
+	}
+
+	fmt.Print("|")
+	fmt.Printf("%v|", u.ID)
+	fmt.Printf("%v|", u.Name)
+	fmt.Printf("%v|", u.Timeout)
+	fmt.Printf("%v|", len(u.Friends))
+	fmt.Printf("%v|", u.Friends[0].ID)
+	fmt.Printf("%v|", u.Friends[0].Name)
+}
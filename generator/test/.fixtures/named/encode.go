@@ -0,0 +1,22 @@
+package main
+
+import (
+	"log"
+	"os"
+	"time"
+)
+
+func main() {
+	u := &User{
+		ID: 100,
+		Name: "John",
+		Timeout: 2 * time.Second,
+		Friends: Users{
+			&User{ID: 200, Name: "Jane"},
+		},
+	}
+	e := NewUserJSONEncoder(os.Stdout)
+	if err := e.Encode(u); err != nil {
+		log.Fatalln("User encoding error: ", err.Error())
+	}
+}
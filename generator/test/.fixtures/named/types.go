@@ -0,0 +1,16 @@
+package main
+
+import "time"
+
+type UserID int64
+
+type Name string
+
+type Users []*User
+
+type User struct {
+    ID UserID
+    Name Name
+    Timeout time.Duration
+    Friends Users
+}
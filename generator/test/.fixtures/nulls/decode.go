@@ -0,0 +1,35 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+)
+
+func main() {
+	// Decode the output of encoding/json for nil slices.
+	b, err := json.Marshal(&A{Empty: []*B{}})
+	if err != nil {
+		log.Fatalln("Marshal error: ", err.Error())
+	}
+	obj := &A{Bs: []*B{{Name: "x"}}}
+	if err := NewAJSONDecoder(strings.NewReader(string(b))).Decode(&obj); err != nil {
+		log.Fatalln("Decoding error: ", err.Error())
+	}
+	fmt.Printf("|%v|%v|%v|", obj.Bs == nil, obj.Empty != nil && len(obj.Empty) == 0, obj.Array)
+
+	// Arrays are left unchanged by null.
+	obj = &A{Array: [2]int{1, 2}, Matrix: [][2]int{{3, 4}}}
+	if err := NewAJSONDecoder(strings.NewReader(`{"Array":null,"Matrix":[null,[5]]}`)).Decode(&obj); err != nil {
+		log.Fatalln("Decoding error: ", err.Error())
+	}
+	fmt.Printf("%v|%v|", obj.Array, obj.Matrix)
+
+	var std A
+	std.Array = [2]int{1, 2}
+	if err := json.Unmarshal([]byte(`{"Array":null,"Matrix":[null,[5]]}`), &std); err != nil {
+		log.Fatalln("Unmarshal error: ", err.Error())
+	}
+	fmt.Printf("%v|%v|", std.Array, std.Matrix)
+}
@@ -0,0 +1,22 @@
+package main
+
+import (
+	"encoding/json"
+	"log"
+	"os"
+)
+
+func main() {
+	obj := &A{Empty: []*B{}, Array: [2]int{1, 2}}
+	e := NewAJSONEncoder(os.Stdout)
+	if err := e.Encode(obj); err != nil {
+		log.Fatalln("Encoding error: ", err.Error())
+	}
+
+	// The output must match encoding/json.
+	b, err := json.Marshal(obj)
+	if err != nil {
+		log.Fatalln("Marshal error: ", err.Error())
+	}
+	os.Stdout.Write(append([]byte{'|'}, b...))
+}
@@ -0,0 +1,12 @@
+package main
+
+type A struct {
+    Bs []*B
+    Empty []*B
+    Array [2]int
+    Matrix [][2]int
+}
+
+type B struct {
+    Name string
+}
@@ -0,0 +1,7 @@
+package main
+
+type A struct {
+	Name string
+	B    *B
+	Bs   []B
+}
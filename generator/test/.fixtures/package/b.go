@@ -0,0 +1,6 @@
+package main
+
+type B struct {
+	ID   int
+	Tags []string
+}
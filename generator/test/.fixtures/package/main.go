@@ -0,0 +1,18 @@
+package main
+
+import (
+	"bytes"
+	"log"
+	"os"
+)
+
+func main() {
+	a := &A{}
+	src := `{"Name":"foo","B":{"ID":1,"Tags":["x"]},"Bs":[{"ID":2,"Tags":null}]}`
+	if err := NewAJSONDecoder(bytes.NewBufferString(src)).Decode(&a); err != nil {
+		log.Fatalln("Decoding error: ", err.Error())
+	}
+	if err := NewAJSONEncoder(os.Stdout).Encode(a); err != nil {
+		log.Fatalln("Encoding error: ", err.Error())
+	}
+}
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"strings"
+)
+
+const DATA = `{"Strings":["foo","bar"],"Ints":[1,-2,3],"Nil":null,"Empty":[],"Values":[{"Name":"x"},{"Name":"y"}],"Ptrs":[{"Name":"z"},null],"Array":[1.5,2,7,-4,[5,{"a":6}]],"Nested":[[1,2],null,[]],"IDs":[100,200],"Matrix":[[true,false],[false]],"Maps":[{"a":1}]}`
+
+func main() {
+	obj := &A{Nil: []int{1}, Array: [4]float64{9, 9, 9, 9}, Matrix: [2][2]bool{{true, true}, {true, true}}}
+	d := NewAJSONDecoder(strings.NewReader(DATA))
+	if err := d.Decode(&obj); err != nil {
+		log.Fatalln("Decoding error: ", err.Error())
+	}
+
+	fmt.Print("|")
+	fmt.Printf("%v|", obj.Strings)
+	fmt.Printf("%v|", obj.Ints)
+	fmt.Printf("%v|", obj.Nil == nil)
+	fmt.Printf("%v|", obj.Empty != nil && len(obj.Empty) == 0)
+	fmt.Printf("%v|", obj.Values)
+	fmt.Printf("%v|", *obj.Ptrs[0])
+	fmt.Printf("%v|", obj.Ptrs[1])
+	fmt.Printf("%v|", obj.Array)
+	fmt.Printf("%v|", obj.Nested)
+	fmt.Printf("%v|", obj.Nested[1] == nil)
+	fmt.Printf("%v|", obj.IDs)
+	fmt.Printf("%v|", obj.Matrix)
+	fmt.Printf("%v|", obj.Maps)
+}
@@ -0,0 +1,25 @@
+package main
+
+import (
+	"log"
+	"os"
+)
+
+func main() {
+	obj := &A{
+		Strings: []string{"foo", "bar"},
+		Ints: []int{1, -2, 3},
+		Empty: []float64{},
+		Values: []B{{Name: "x"}, {Name: "y"}},
+		Ptrs: []*B{{Name: "z"}, nil},
+		Array: [4]float64{1.5, 2, 0, -4},
+		Nested: [][]int{{1, 2}, nil, {}},
+		IDs: IDs{100, 200},
+		Matrix: [2][2]bool{{true, false}, {false, true}},
+		Maps: []map[string]int{{"a": 1}},
+	}
+	e := NewAJSONEncoder(os.Stdout)
+	if err := e.Encode(obj); err != nil {
+		log.Fatalln("Encoding error: ", err.Error())
+	}
+}
@@ -0,0 +1,23 @@
+package main
+
+type A struct {
+    Strings []string
+    Ints []int
+    Nil []int
+    Empty []float64
+    Values []B
+    Ptrs []*B
+    Array [4]float64
+    Nested [][]int
+    IDs IDs
+    Matrix [2][2]bool
+    Maps []map[string]int
+}
+
+type B struct {
+    Name string
+}
+
+type IDs []ID
+
+type ID int64
@@ -0,0 +1,25 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+func main() {
+	obj := &A{}
+	err := NewAJSONDecoder(strings.NewReader(`{"Name":"foo","Extra":{"a":[1,{"b":2}]},"Age":3}`)).Decode(&obj)
+	fmt.Printf("|%v|%v|%v|", obj.Name, obj.Age, err)
+
+	for _, data := range []string{
+		`{"Name":"foo","Extra":1}`,
+		`{"Age":"x"}`,
+		`{"Age":1} {}`,
+		`{"Age":2}`,
+	} {
+		obj := &A{}
+		d := NewAJSONDecoder(strings.NewReader(data))
+		d.Strict()
+		err := d.Decode(&obj)
+		fmt.Printf("%v|", err)
+	}
+}
@@ -0,0 +1,6 @@
+package main
+
+type A struct {
+	Name string
+	Age  int
+}
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"strings"
+)
+
+const DATA = `{"first":"x","name":"foo","Count":2,"id":"1000","ok":"true","label":"\"bar\"","n":"5","niln":null,"s":"\"x\"","-":"baz"}`
+
+func main() {
+	var obj *A
+	d := NewAJSONDecoder(strings.NewReader(DATA))
+	if err := d.Decode(&obj); err != nil {
+		log.Fatalln("Decoding error: ", err.Error())
+	}
+
+	fmt.Print("|")
+	fmt.Printf("%v|", obj.First)
+	fmt.Printf("%v|", obj.Name)
+	fmt.Printf("%v|", obj.Count)
+	fmt.Printf("%v|", obj.ID)
+	fmt.Printf("%v|", obj.Ok)
+	fmt.Printf("%v|", obj.Label)
+	fmt.Printf("%v|%v|%v|", *obj.N, obj.NilN, *obj.S)
+	fmt.Printf("%v|", obj.Dash)
+
+	for _, data := range []string{`{"id":"5 6"}`, `{"n":"5 6"}`, `{"ok":"true}"}`, `{"id":"x"}`} {
+		err := NewAJSONDecoder(strings.NewReader(data)).Decode(&obj)
+		fmt.Printf("%v|", err)
+	}
+}
@@ -0,0 +1,24 @@
+package main
+
+import (
+	"log"
+	"os"
+)
+
+func main() {
+	n, str := 5, "x"
+	obj := &A{
+		Name: "foo",
+		Tags: map[string]string{},
+		ID: 1000,
+		Ok: true,
+		Label: "bar",
+		N: &n,
+		S: &str,
+		Dash: "baz",
+	}
+	e := NewAJSONEncoder(os.Stdout)
+	if err := e.Encode(obj); err != nil {
+		log.Fatalln("Encoding error: ", err.Error())
+	}
+}
@@ -0,0 +1,22 @@
+package main
+
+type A struct {
+    First string `json:"first,omitempty"`
+    Name string `json:"name"`
+    Count int `json:",omitempty"`
+    Empty bool `json:"empty,omitempty"`
+    B *B `json:"b,omitempty"`
+    Bs []*B `json:"bs,omitempty"`
+    Tags map[string]string `json:"tags,omitempty"`
+    ID int64 `json:"id,string"`
+    Ok bool `json:"ok,string"`
+    Label string `json:"label,string"`
+    N *int `json:"n,string"`
+    NilN *int `json:"niln,string"`
+    S *string `json:"s,string"`
+    Dash string `json:"-,"`
+}
+
+type B struct {
+    Name string
+}
@@ -0,0 +1,25 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"strings"
+)
+
+const DATA = `{"Name":"foo","Address":{"Street":"Main St"},"Home":{"Street":"Elm St","zip":1},"inner":{"Version":2},"Points":[{"X":1,"Y":2},{"X":3,"Y":4}],"Index":{"a":{"X":5,"Y":6}}}`
+
+func main() {
+	obj := &A{Address: Address{Zip: 12345}}
+	d := NewAJSONDecoder(strings.NewReader(DATA))
+	if err := d.Decode(&obj); err != nil {
+		log.Fatalln("Decoding error: ", err.Error())
+	}
+
+	fmt.Print("|")
+	fmt.Printf("%v|", obj.Name)
+	fmt.Printf("%v|", obj.Address)
+	fmt.Printf("%v|", *obj.Home)
+	fmt.Printf("%v|", obj.Inner)
+	fmt.Printf("%v|", obj.Points)
+	fmt.Printf("%v|", obj.Index)
+}
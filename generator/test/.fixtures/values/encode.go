@@ -0,0 +1,20 @@
+package main
+
+import (
+	"log"
+	"os"
+)
+
+func main() {
+	obj := &A{
+		Name: "foo",
+		Address: Address{Street: "Main St", Zip: 12345},
+		Inner: Inner{Version: 2},
+		Points: []Point{{1, 2}, {3, 4}},
+		Index: map[string]Point{"a": {5, 6}},
+	}
+	e := NewAJSONEncoder(os.Stdout)
+	if err := e.Encode(obj); err != nil {
+		log.Fatalln("Encoding error: ", err.Error())
+	}
+}
@@ -0,0 +1,23 @@
+package main
+
+type A struct {
+    Name string
+    Address Address
+    Home *Address
+    Inner `json:"inner"`
+    Points []Point
+    Index map[string]Point
+}
+
+type Address struct {
+    Street string
+    Zip int `json:"zip,omitempty"`
+}
+
+type Inner struct {
+    Version int
+}
+
+type Point struct {
+    X, Y int
+}
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"strings"
+)
+
+const DATA = `{"I8":-128,"I16":-32768,"I32":-2147483648,"U8":255,"U16":65535,"U32":4294967295,"Ptr":1234,"Byte":97,"Rune":233,"Small":127,"Quoted":"300","Keys":{"-1":1}}`
+
+func main() {
+	var obj *A
+	d := NewAJSONDecoder(strings.NewReader(DATA))
+	if err := d.Decode(&obj); err != nil {
+		log.Fatalln("Decoding error: ", err.Error())
+	}
+
+	fmt.Print("|")
+	fmt.Printf("%v|", obj.I8)
+	fmt.Printf("%v|", obj.I16)
+	fmt.Printf("%v|", obj.I32)
+	fmt.Printf("%v|", obj.U8)
+	fmt.Printf("%v|", obj.U16)
+	fmt.Printf("%v|", obj.U32)
+	fmt.Printf("%v|", obj.Ptr)
+	fmt.Printf("%v|", obj.Byte)
+	fmt.Printf("%v|", obj.Rune)
+	fmt.Printf("%v|", obj.Small)
+	fmt.Printf("%v|", obj.Quoted)
+	fmt.Printf("%v|", obj.Keys)
+
+	// Out of range numbers return an error.
+	for _, data := range []string{`{"I8":128}`, `{"U16":-1}`, `{"Small":1.5}`} {
+		if err := NewAJSONDecoder(strings.NewReader(data)).Decode(&obj); err != nil {
+			fmt.Printf("%v|", err)
+		}
+	}
+}
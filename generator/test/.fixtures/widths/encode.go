@@ -0,0 +1,27 @@
+package main
+
+import (
+	"log"
+	"os"
+)
+
+func main() {
+	obj := &A{
+		I8: -128,
+		I16: -32768,
+		I32: -2147483648,
+		U8: 255,
+		U16: 65535,
+		U32: 4294967295,
+		Ptr: 1234,
+		Byte: 'a',
+		Rune: 'é',
+		Small: 127,
+		Quoted: 300,
+		Keys: map[int8]uint16{-1: 1},
+	}
+	e := NewAJSONEncoder(os.Stdout)
+	if err := e.Encode(obj); err != nil {
+		log.Fatalln("Encoding error: ", err.Error())
+	}
+}
@@ -0,0 +1,18 @@
+package main
+
+type A struct {
+    I8 int8
+    I16 int16
+    I32 int32
+    U8 uint8
+    U16 uint16
+    U32 uint32
+    Ptr uintptr
+    Byte byte
+    Rune rune
+    Small Small
+    Quoted int16 `json:",string"`
+    Keys map[int8]uint16
+}
+
+type Small int8
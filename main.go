@@ -3,6 +3,9 @@ package main
 import (
 	"flag"
 	"log"
+	"os"
+	"path/filepath"
+	"strings"
 
 	"github.com/benbjohnson/megajson/generator"
 )
@@ -12,18 +15,85 @@ func init() {
 }
 
 func main() {
+	var options generator.Options
+	flag.BoolVar(&options.Encoder.SortKeys, "sortkeys", false, "write map keys in sorted order")
+	flag.BoolVar(&options.Encoder.Marshaler, "marshaler", false, "generate MarshalJSON and AppendJSON methods")
+	flag.BoolVar(&options.Decoder.Unmarshaler, "unmarshaler", false, "generate UnmarshalJSON methods")
+	flag.BoolVar(&options.Decoder.CaseInsensitive, "caseinsensitive", false, "match object keys case-insensitively when there is no exact match")
+	flag.BoolVar(&options.Package, "package", false, "generate a single file for each package")
+	flag.StringVar(&options.Filename, "filename", generator.DefaultFilename, "name of the file generated in package mode")
+	flag.StringVar(&options.Output, "o", "", "write all generated code to a single file")
+	flag.StringVar(&options.PackageName, "pkg", "", "package name of the generated code")
+	flag.BoolVar(&options.EncoderOnly, "encoder", false, "only generate encoders")
+	flag.BoolVar(&options.DecoderOnly, "decoder", false, "only generate decoders")
+	flag.Var((*names)(&options.Tags), "tags", "comma-separated list of build tags")
+	stdout := flag.Bool("stdout", false, "write generated code to stdout instead of files")
+	flag.BoolVar(&options.Check, "check", false, "print a diff and exit non-zero if generated files are out of date")
+	flag.Var((*names)(&options.Types), "types", "comma-separated list of struct types to generate code for")
+	flag.Var((*globs)(&options.Include), "include", "only generate from files matching a glob pattern (repeatable)")
+	flag.Var((*globs)(&options.Exclude), "exclude", "skip files and directories matching a glob pattern (repeatable)")
+	flag.Usage = usage
 	flag.Parse()
-	if flag.NArg() == 0 {
-		usage()
+	if *stdout || options.Check {
+		options.Writer = os.Stdout
 	}
 
+	// Default to the file that invoked "go generate".
 	path := flag.Arg(0)
-	g := generator.New()
-	if err := g.Generate(path); err != nil {
+	if path == "" {
+		if path = os.Getenv("GOFILE"); path == "" {
+			usage()
+		}
+		if options.PackageName == "" {
+			options.PackageName = os.Getenv("GOPACKAGE")
+		}
+	}
+
+	g := generator.New(options)
+	if err := g.Generate(path); err == generator.ErrStale {
+		os.Exit(1)
+	} else if err != nil {
 		log.Fatalln(err)
 	}
 }
 
+// globs is a flag which can be passed multiple times to build a list of
+// glob patterns.
+type globs []string
+
+func (g *globs) String() string {
+	return strings.Join(*g, ",")
+}
+
+func (g *globs) Set(value string) error {
+	if _, err := filepath.Match(value, ""); err != nil {
+		return err
+	}
+	*g = append(*g, value)
+	return nil
+}
+
+// names is a flag holding a comma-separated list of names.
+type names []string
+
+func (n *names) String() string {
+	return strings.Join(*n, ",")
+}
+
+func (n *names) Set(value string) error {
+	for _, name := range strings.Split(value, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			*n = append(*n, name)
+		}
+	}
+	return nil
+}
+
 func usage() {
-	log.Fatal("usage: megajson OPTIONS FILE")
+	log.Println("usage: megajson [flags] [path]")
+	log.Println("")
+	log.Println("The path defaults to $GOFILE when run by go generate.")
+	log.Println("")
+	flag.PrintDefaults()
+	os.Exit(2)
 }
@@ -0,0 +1,8 @@
+package scanner
+
+// NewBytesScanner initializes a new scanner which tokenizes a byte slice in
+// place. Numbers and strings without escapes are returned as sub-slices of
+// data so it must not be modified while the scanner is in use.
+func NewBytesScanner(data []byte) Scanner {
+	return &scanner{buf: data, line: 1, maxdepth: DefaultMaxDepth}
+}
@@ -0,0 +1,71 @@
+package scanner
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Position is a location in the input.
+type Position struct {
+	Offset int // byte offset, starting at 0
+	Line   int // line number, starting at 1
+	Column int // byte offset within the line, starting at 1
+}
+
+// String returns the line and column of the position.
+func (p Position) String() string {
+	return fmt.Sprintf("line %d, column %d", p.Line, p.Column)
+}
+
+// SyntaxError is returned when the input is not valid JSON or does not have
+// the structure being decoded.
+type SyntaxError struct {
+	Msg      string // description of the error when it is not an unexpected token
+	Expected string // description of the expected token
+	Actual   string // description of the token which was read
+	Path     string // path of the value being read, such as ".items[3].price"
+	Position
+}
+
+func (e *SyntaxError) Error() string {
+	msg := e.Msg
+	if msg == "" {
+		msg = "Unexpected " + e.Actual
+	}
+	msg += " at " + e.Position.String()
+	if e.Path != "" {
+		msg += " in " + e.Path
+	}
+	if e.Expected != "" {
+		msg += "; expected " + e.Expected
+	}
+	return msg
+}
+
+// UnmarshalTypeError is returned when a value cannot be stored in a Go
+// variable of a given type.
+type UnmarshalTypeError struct {
+	Value string // description of the value, such as "number 300"
+	Type  string // name of the Go type
+	Path  string // path of the value being read, such as ".items[3].price"
+	Position
+}
+
+func (e *UnmarshalTypeError) Error() string {
+	msg := "Cannot read " + e.Value + " into " + e.Type + " at " + e.Position.String()
+	if e.Path != "" {
+		msg += " in " + e.Path
+	}
+	return msg
+}
+
+// describe returns a human readable description of a token and its value.
+func describe(tok int, b []byte) string {
+	switch tok {
+	case TSTRING:
+		return "string " + strconv.Quote(string(b))
+	case TNUMBER:
+		return "number " + string(b)
+	}
+	return TokenName(tok)
+}
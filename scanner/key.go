@@ -0,0 +1,23 @@
+package scanner
+
+import (
+	"strings"
+)
+
+// FoldKey returns the key that an object key matches using the same rules
+// as encoding/json. An exact match is preferred, otherwise the first key
+// which is equal under Unicode case folding is returned. The object key is
+// returned unchanged if nothing matches.
+func FoldKey(key string, keys ...string) string {
+	for _, k := range keys {
+		if k == key {
+			return key
+		}
+	}
+	for _, k := range keys {
+		if strings.EqualFold(k, key) {
+			return k
+		}
+	}
+	return key
+}
@@ -0,0 +1,85 @@
+package scanner
+
+import (
+	"strconv"
+)
+
+// frame is an object or array that the scanner is inside of.
+type frame struct {
+	array  bool
+	index  int      // index of the current array item
+	key    []byte   // key of the current object member
+	keypos Position // position of the key
+	haskey bool     // true once the first key has been read
+	inkey  bool     // true when the next string is a key
+}
+
+// visit updates the path of the scanner after a token is read from the input.
+func (s *scanner) visit(tok int, b []byte) {
+	switch tok {
+	case TLBRACE, TLBRACKET:
+		// Reuse the key buffers of frames which have been popped.
+		if n := len(s.frames); n < cap(s.frames) {
+			s.frames = s.frames[:n+1]
+		} else {
+			s.frames = append(s.frames, frame{})
+		}
+		f := &s.frames[len(s.frames)-1]
+		f.array, f.index, f.key = tok == TLBRACKET, 0, f.key[:0]
+		f.haskey, f.inkey = false, tok == TLBRACE
+		return
+	case TRBRACE, TRBRACKET:
+		if len(s.frames) > 0 {
+			s.frames = s.frames[:len(s.frames)-1]
+		}
+		return
+	}
+
+	if len(s.frames) == 0 {
+		return
+	}
+	f := &s.frames[len(s.frames)-1]
+	switch {
+	case tok == TCOMMA && f.array:
+		f.index++
+	case tok == TCOMMA:
+		f.inkey = true
+	case tok == TSTRING && f.inkey:
+		f.key, f.keypos = append(f.key[:0], b...), s.tokpos
+		f.haskey, f.inkey = true, false
+	}
+}
+
+// Path returns the path of the last scanned token, such as ".items[3].price".
+// Keys which are not identifiers are quoted, such as `["first name"]`.
+func (s *scanner) Path() string {
+	var b []byte
+	for _, f := range s.frames[:s.depth] {
+		if f.array {
+			b = append(b, '[')
+			b = strconv.AppendInt(b, int64(f.index), 10)
+			b = append(b, ']')
+		} else if f.haskey && isIdent(f.key) {
+			b = append(b, '.')
+			b = append(b, f.key...)
+		} else if f.haskey {
+			b = append(b, '[')
+			b = strconv.AppendQuote(b, string(f.key))
+			b = append(b, ']')
+		}
+	}
+	return string(b)
+}
+
+// isIdent returns true if a key only contains letters, digits and underscores.
+func isIdent(key []byte) bool {
+	if len(key) == 0 {
+		return false
+	}
+	for _, c := range key {
+		if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' || c == '_') {
+			return false
+		}
+	}
+	return true
+}
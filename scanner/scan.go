@@ -0,0 +1,462 @@
+package scanner
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// errMore is returned when a token may continue past the end of the buffer.
+var errMore = errors.New("more input needed")
+
+// fill reads more input into the buffer. The bytes before index keep are
+// discarded to make room and the number discarded is returned so that the
+// caller can adjust its indexes. The buffer grows when it is full of the
+// kept bytes. io.EOF is returned at the end of the input.
+func (s *scanner) fill(keep int) (int, error) {
+	if s.r == nil {
+		return 0, io.EOF
+	} else if s.err != nil {
+		return 0, s.err
+	}
+
+	if keep > 0 {
+		s.buf = s.buf[:copy(s.buf[:cap(s.buf)], s.buf[keep:])]
+		s.base += keep
+	}
+	if len(s.buf) == cap(s.buf) {
+		buf := make([]byte, len(s.buf), 2*cap(s.buf))
+		copy(buf, s.buf)
+		s.buf = buf
+	}
+
+	for {
+		n, err := s.r.Read(s.buf[len(s.buf):cap(s.buf)])
+		s.buf = s.buf[:len(s.buf)+n]
+		if err != nil {
+			s.err = err
+		}
+		if n > 0 {
+			return keep, nil
+		} else if err != nil {
+			return keep, err
+		}
+	}
+}
+
+// ensure fills the buffer until there are at least n bytes from index i.
+// It returns the new index of the byte at i.
+func (s *scanner) ensure(i, n int) (int, error) {
+	for len(s.buf)-i < n {
+		shift, err := s.fill(i)
+		i -= shift
+		if err != nil {
+			return i, err
+		}
+	}
+	return i, nil
+}
+
+// scan reads the next token from the buffer. Input which ends in the
+// middle of a token returns io.ErrUnexpectedEOF.
+func (s *scanner) scan() (int, []byte, error) {
+	// Skip whitespace. Newlines can only appear between tokens so lines are
+	// only counted here.
+	i := s.idx
+	for {
+		if i >= len(s.buf) {
+			shift, err := s.fill(i)
+			i -= shift
+			if err != nil {
+				s.idx = i
+				return 0, nil, err
+			}
+			continue
+		}
+		if c := s.buf[i]; c == '\n' {
+			s.line++
+			s.linestart = s.base + i + 1
+		} else if c != ' ' && c != '\t' && c != '\r' {
+			break
+		}
+		i++
+	}
+
+	s.tokpos = Position{Offset: s.base + i, Line: s.line, Column: s.base + i - s.linestart + 1}
+	switch s.buf[i] {
+	case '{':
+		s.idx = i + 1
+		return TLBRACE, s.buf[i : i+1], nil
+	case '}':
+		s.idx = i + 1
+		return TRBRACE, s.buf[i : i+1], nil
+	case '[':
+		s.idx = i + 1
+		return TLBRACKET, s.buf[i : i+1], nil
+	case ']':
+		s.idx = i + 1
+		return TRBRACKET, s.buf[i : i+1], nil
+	case ':':
+		s.idx = i + 1
+		return TCOLON, s.buf[i : i+1], nil
+	case ',':
+		s.idx = i + 1
+		return TCOMMA, s.buf[i : i+1], nil
+	}
+
+	var tok int
+	var b []byte
+	var err error
+	switch c := s.buf[i]; {
+	case c == '"':
+		tok, b, err = s.scanString(i)
+	case c == 't':
+		tok, b, err = s.scanLiteral(i, "true", TTRUE)
+	case c == 'f':
+		tok, b, err = s.scanLiteral(i, "false", TFALSE)
+	case c == 'n':
+		tok, b, err = s.scanLiteral(i, "null", TNULL)
+	case (c >= '0' && c <= '9') || c == '-':
+		tok, b, err = s.scanNumber(i)
+	default:
+		return 0, nil, s.errorAt(i, "", "value")
+	}
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	return tok, b, err
+}
+
+// scanLiteral reads the true, false or null token starting at index i.
+func (s *scanner) scanLiteral(i int, lit string, tok int) (int, []byte, error) {
+	i, err := s.ensure(i, len(lit))
+	if err != nil && err != io.EOF {
+		return 0, nil, err
+	}
+	for j := 1; j < len(lit); j++ {
+		if i+j >= len(s.buf) || s.buf[i+j] != lit[j] {
+			return 0, nil, s.errorAt(i+j, "", strconv.QuoteRune(rune(lit[j])))
+		}
+	}
+	s.idx = i + len(lit)
+	return tok, nil, nil
+}
+
+// scanNumber reads the number starting at index i. The integer part may only
+// start with a zero if it is zero and the fraction and exponent must have at
+// least one digit. Exponents are returned with a lowercase 'e' and without
+// a '+' sign.
+func (s *scanner) scanNumber(i int) (int, []byte, error) {
+	var eof bool
+	for {
+		end, err := s.numberEnd(i, eof)
+		if err == errMore {
+			if err := s.checkSize(len(s.buf)); err != nil {
+				return 0, nil, err
+			}
+			shift, err := s.fill(i)
+			i -= shift
+			if err == io.EOF {
+				eof = true
+			} else if err != nil {
+				return 0, nil, err
+			}
+			continue
+		} else if err != nil {
+			return 0, nil, err
+		} else if err := s.checkSize(end); err != nil {
+			return 0, nil, err
+		}
+		s.idx = end
+		return TNUMBER, s.normalize(s.buf[i:end]), nil
+	}
+}
+
+// numberEnd returns the index after the number starting at index i. It
+// returns errMore if the number may continue past the end of the buffer
+// unless the end of the input has been reached.
+func (s *scanner) numberEnd(i int, eof bool) (int, error) {
+	data := s.buf
+	more := func(i int) (int, error) {
+		if eof {
+			return i, nil
+		}
+		return 0, errMore
+	}
+
+	if data[i] == '-' {
+		i++
+	}
+
+	// Read whole number.
+	if i >= len(data) {
+		if eof {
+			return 0, io.EOF
+		}
+		return 0, errMore
+	} else if data[i] == '0' {
+		i++
+		if i < len(data) && data[i] >= '0' && data[i] <= '9' {
+			return 0, s.errorAt(i, "Leading zero in number", "")
+		}
+	} else if j := digits(data, i); j == i {
+		return 0, s.errorAt(i, "", "digit")
+	} else {
+		i = j
+	}
+
+	// Read fraction.
+	if i >= len(data) {
+		return more(i)
+	} else if data[i] == '.' {
+		i++
+		j := digits(data, i)
+		if j == len(data) && !eof {
+			return 0, errMore
+		} else if j == i {
+			return 0, s.errorAt(i, "", "digit")
+		}
+		i = j
+	}
+
+	// Read exponent.
+	if i >= len(data) {
+		return more(i)
+	} else if data[i] == 'e' || data[i] == 'E' {
+		i++
+		if i < len(data) && (data[i] == '-' || data[i] == '+') {
+			i++
+		}
+		j := digits(data, i)
+		if j == len(data) && !eof {
+			return 0, errMore
+		} else if j == i {
+			return 0, s.errorAt(i, "", "digit")
+		}
+		i = j
+	}
+
+	if i >= len(data) {
+		return more(i)
+	}
+	return i, nil
+}
+
+// digits returns the index of the first byte at or after i which is not a digit.
+func digits(data []byte, i int) int {
+	for i < len(data) && data[i] >= '0' && data[i] <= '9' {
+		i++
+	}
+	return i
+}
+
+// normalize rewrites an exponent with an uppercase 'E' or a '+' sign into the
+// scratch buffer. Other numbers are returned unchanged.
+func (s *scanner) normalize(b []byte) []byte {
+	for i, c := range b {
+		if c != 'E' && c != '+' {
+			continue
+		}
+		n := append(s.scratch[:0], b[:i]...)
+		for _, c := range b[i:] {
+			if c == 'E' {
+				n = append(n, 'e')
+			} else if c != '+' {
+				n = append(n, c)
+			}
+		}
+		s.scratch = n
+		return n
+	}
+	return b
+}
+
+// scanString reads the string whose opening quote is at index i. Strings
+// without escapes are returned as a slice of the buffer. UTF-8 is only
+// validated when a string has bytes which are not ASCII.
+func (s *scanner) scanString(i int) (int, []byte, error) {
+	start, j := i+1, i+1
+	var nonascii bool
+	for {
+		n, hi := stringEnd(s.buf[j:])
+		j, nonascii = j+n, nonascii || hi
+		if j < len(s.buf) {
+			break
+		} else if err := s.checkSize(j); err != nil {
+			return 0, nil, err
+		}
+		shift, err := s.fill(start)
+		start, j = start-shift, j-shift
+		if err != nil {
+			return 0, nil, err
+		}
+	}
+
+	if s.buf[j] != '"' || (nonascii && !utf8.Valid(s.buf[start:j])) {
+		return s.scanEscaped(start)
+	} else if err := s.checkSize(j + 1); err != nil {
+		return 0, nil, err
+	}
+	s.idx = j + 1
+	return TSTRING, s.buf[start:j], nil
+}
+
+// scanEscaped decodes the string whose contents start at index i into the
+// scratch buffer. Escaped UTF-16 surrogate pairs are decoded into a single
+// rune and unpaired surrogates and invalid UTF-8 are replaced with U+FFFD.
+func (s *scanner) scanEscaped(i int) (int, []byte, error) {
+	b := s.scratch[:0]
+	var high rune
+	for {
+		// Copy the bytes up to the next quote, escape or control character.
+		n, _ := stringEnd(s.buf[i:])
+		if i+n >= len(s.buf) {
+			if err := s.checkSize(len(s.buf)); err != nil {
+				return 0, nil, err
+			}
+			shift, err := s.fill(i)
+			i -= shift
+			if err != nil {
+				return 0, nil, err
+			}
+			continue
+		}
+		if high != 0 && (n > 0 || s.buf[i+n] != '\\') {
+			b = utf8.AppendRune(b, utf8.RuneError)
+			high = 0
+		}
+		b = appendValid(b, s.buf[i:i+n])
+		i += n
+
+		switch c := s.buf[i]; {
+		case c == '"':
+			if err := s.checkSize(i + 1); err != nil {
+				return 0, nil, err
+			}
+			s.idx, s.scratch = i+1, b
+			return TSTRING, b, nil
+		case c < 0x20:
+			return 0, nil, s.errorAt(i, "Unescaped control character in string", "")
+		}
+
+		// Read an escape.
+		var err error
+		if i, err = s.ensure(i, 2); err != nil {
+			return 0, nil, err
+		}
+		if s.buf[i+1] == 'u' {
+			if i, err = s.ensure(i, 6); err != nil && err != io.EOF {
+				return 0, nil, err
+			}
+			r, err := s.scanHex(i + 2)
+			if err != nil {
+				return 0, nil, err
+			}
+			i += 6
+			if high != 0 && r >= 0xDC00 && r < 0xE000 {
+				b = utf8.AppendRune(b, utf16.DecodeRune(high, r))
+				high = 0
+				continue
+			} else if high != 0 {
+				b = utf8.AppendRune(b, utf8.RuneError)
+				high = 0
+			}
+			if r >= 0xD800 && r < 0xDC00 {
+				high = r
+			} else {
+				b = utf8.AppendRune(b, r)
+			}
+			continue
+		}
+
+		if high != 0 {
+			b = utf8.AppendRune(b, utf8.RuneError)
+			high = 0
+		}
+		switch c := s.buf[i+1]; c {
+		case '"', '\\', '/':
+			b = append(b, c)
+		case 'b':
+			b = append(b, '\b')
+		case 'f':
+			b = append(b, '\f')
+		case 'n':
+			b = append(b, '\n')
+		case 'r':
+			b = append(b, '\r')
+		case 't':
+			b = append(b, '\t')
+		default:
+			r, _ := utf8.DecodeRune(s.buf[i+1:])
+			return 0, nil, s.errorAt(i+1, "Invalid escape character "+strconv.Quote(`\`+string(r)), "")
+		}
+		i += 2
+	}
+}
+
+// scanHex reads the four hexadecimal digits of a unicode escape at index i.
+func (s *scanner) scanHex(i int) (rune, error) {
+	var r rune
+	for j := i; j < i+4; j++ {
+		if j >= len(s.buf) {
+			return 0, io.EOF
+		}
+		switch c := rune(s.buf[j]); {
+		case c >= '0' && c <= '9':
+			r = r<<4 | (c - '0')
+		case c >= 'a' && c <= 'f':
+			r = r<<4 | (c - 'a' + 10)
+		case c >= 'A' && c <= 'F':
+			r = r<<4 | (c - 'A' + 10)
+		default:
+			return 0, s.errorAt(j, "", "hexadecimal digit")
+		}
+	}
+	return r, nil
+}
+
+// appendValid appends UTF-8 to b with each invalid byte replaced by U+FFFD.
+func appendValid(b, v []byte) []byte {
+	if utf8.Valid(v) {
+		return append(b, v...)
+	}
+	for len(v) > 0 {
+		r, size := utf8.DecodeRune(v)
+		b = utf8.AppendRune(b, r)
+		v = v[size:]
+	}
+	return b
+}
+
+// checkSize returns an error if the token being scanned extends to index i
+// of the buffer and is larger than the maximum token size. It is checked
+// before the buffer grows so that long tokens are never buffered whole.
+func (s *scanner) checkSize(i int) error {
+	if s.max <= 0 || s.base+i-s.tokpos.Offset <= s.max {
+		return nil
+	}
+	return &SyntaxError{
+		Msg:      fmt.Sprintf("Token exceeds the maximum size of %d bytes", s.max),
+		Path:     s.Path(),
+		Position: s.tokpos,
+	}
+}
+
+// errorAt returns a syntax error for the character at index i of the buffer
+// or io.EOF if i is past the end of the buffer.
+func (s *scanner) errorAt(i int, msg, expected string) error {
+	if i >= len(s.buf) {
+		return io.EOF
+	}
+	r, _ := utf8.DecodeRune(s.buf[i:])
+	return &SyntaxError{
+		Msg:      msg,
+		Expected: expected,
+		Actual:   "character " + strconv.QuoteRune(r),
+		Path:     s.Path(),
+		Position: Position{Offset: s.base + i, Line: s.line, Column: s.base + i - s.linestart + 1},
+	}
+}
@@ -1,554 +1,458 @@
 package scanner
 
 import (
+	"encoding/base64"
 	"fmt"
 	"io"
 	"strconv"
-	"unicode/utf8"
 )
 
 const (
-	// The size, in bytes, that is read from the reader at a time.
+	// The initial size, in bytes, of the buffer that is read into. It grows
+	// when a token does not fit.
 	bufSize = 4096
 )
 
+// DefaultMaxDepth is the number of objects and arrays that a value can be
+// nested inside of by default, the same as encoding/json. Deeper input
+// returns an error instead of overflowing the stack of the recursive
+// decoders.
+const DefaultMaxDepth = 10000
+
+var hex = "0123456789abcdef"
+
 // Scanner is a tokenizer for JSON input from an io.Reader.
 type Scanner interface {
 	Pos() int
+	Position() Position
+	Path() string
 	Scan() (int, []byte, error)
 	Unscan(tok int, b []byte)
 	ReadString(target *string) error
 	ReadInt(target *int) error
+	ReadInt8(target *int8) error
+	ReadInt16(target *int16) error
+	ReadInt32(target *int32) error
 	ReadInt64(target *int64) error
 	ReadUint(target *uint) error
+	ReadUint8(target *uint8) error
+	ReadUint16(target *uint16) error
+	ReadUint32(target *uint32) error
 	ReadUint64(target *uint64) error
+	ReadUintptr(target *uintptr) error
 	ReadFloat32(target *float32) error
 	ReadFloat64(target *float64) error
 	ReadBool(target *bool) error
+	ReadBytes(target *[]byte) error
 	ReadMap(target *map[string]interface{}) error
 	ReadArray(target *[]interface{}) error
+	ReadInterface(target *interface{}) error
+	ReadRaw(target *[]byte) error
+	Skip() error
+	End() error
+	SetStrict(strict bool)
+	Strict() bool
+	SetMaxTokenSize(n int)
+	SetMaxDepth(n int)
+	Unexpected(tok int, b []byte, expected string) error
+	TypeError(tok int, b []byte, typ string) error
+	UnknownField(key string) error
 }
 
 type scanner struct {
-	r       io.Reader
-	c       rune
-	scratch [bufSize]byte
-	buf     [bufSize]byte
-	buflen  int
-	idx     int
-	pos     int
-	tmpc    rune
-	tmp     struct {
-		tok int
-		b   []byte
-		err error
-	}
+	r        io.Reader
+	err      error
+	buf      []byte
+	idx      int
+	base     int
+	scratch  []byte
+	strict   bool
+	max      int
+	maxdepth int
+	tmp      struct {
+		tok   int
+		b     []byte
+		err   error
+		pos   Position
+		depth int
+	}
+
+	// Line of the next byte and position of the last scanned token.
+	line      int
+	linestart int
+	tokpos    Position
+
+	// Objects and arrays containing the last scanned token.
+	frames []frame
+	depth  int
 }
 
 // NewScanner initializes a new scanner with a given reader.
 func NewScanner(r io.Reader) Scanner {
-	s := &scanner{r: r, buflen: -1}
+	s := &scanner{r: r, buf: make([]byte, 0, bufSize), line: 1, maxdepth: DefaultMaxDepth}
 	return s
 }
 
-// Pos returns the current rune position of the scanner.
-func (s *scanner) Pos() int {
-	return s.pos
+// SetStrict sets whether values of the wrong type are errors. Otherwise
+// they are read as the zero value of the target type.
+func (s *scanner) SetStrict(strict bool) {
+	s.strict = strict
 }
 
-// read retrieves the next rune from the reader.
-func (s *scanner) read() error {
-	if s.tmpc > 0 {
-		s.c = s.tmpc
-		s.tmpc = 0
-		return nil
-	}
-
-	// Read from the reader if the buffer is empty.
-	if s.idx >= s.buflen {
-		var err error
-		if s.buflen, err = s.r.Read(s.buf[0:]); err != nil {
-			return err
-		}
-		s.idx = 0
-	}
-
-	// Read a single byte and then determine if utf8 decoding is needed.
-	b := s.buf[s.idx]
-	if b < utf8.RuneSelf {
-		s.c = rune(b)
-		s.idx++
-	} else {
-		// Read a new buffer if we don't have at least the max size of a UTF8 character.
-		if s.idx+utf8.UTFMax >= s.buflen {
-			s.buf[0] = b
-			var err error
-			if s.buflen, err = s.r.Read(s.buf[1:]); err != nil {
-				return err
-			}
-			s.buflen += 1
-		}
+// Strict returns true if values of the wrong type are errors.
+func (s *scanner) Strict() bool {
+	return s.strict
+}
 
-		var size int
-		s.c, size = utf8.DecodeRune(s.buf[s.idx:])
-		s.idx += size
-	}
+// SetMaxTokenSize limits the size, in bytes, of each string and number in
+// the input. Larger tokens return a SyntaxError instead of being buffered.
+// Zero, the default, is no limit.
+func (s *scanner) SetMaxTokenSize(n int) {
+	s.max = n
+}
 
-	s.pos++
-	return nil
+// SetMaxDepth sets the number of objects and arrays that a value can be
+// nested inside of. Deeper input returns a SyntaxError. Zero is no limit.
+func (s *scanner) SetMaxDepth(n int) {
+	s.maxdepth = n
 }
 
-// unread places the current rune back on the reader.
-func (s *scanner) unread() {
-	s.tmpc = s.c
+// Pos returns the byte offset of the last scanned token.
+func (s *scanner) Pos() int {
+	return s.tokpos.Offset
 }
 
-// expect reads the next rune and checks that it matches.
-func (s *scanner) expect(c rune) error {
-	if err := s.read(); err != nil {
-		return err
-	} else if s.c != c {
-		return fmt.Errorf("Unexpected char: %q", s.c)
-	}
-	return nil
+// Position returns the position of the last scanned token.
+func (s *scanner) Position() Position {
+	return s.tokpos
 }
 
-// Scan returns the next JSON token from the reader.
+// Scan returns the next JSON token from the reader. It returns io.EOF at
+// the end of the input and io.ErrUnexpectedEOF if the input ends inside of
+// an object or array.
 func (s *scanner) Scan() (int, []byte, error) {
 	if s.tmp.tok != 0 {
 		tok, b := s.tmp.tok, s.tmp.b
 		s.tmp.tok, s.tmp.b = 0, nil
+		s.tokpos, s.depth = s.tmp.pos, s.tmp.depth
 		return tok, b, nil
 	}
 
-	for {
-		if err := s.read(); err != nil {
-			return 0, nil, err
-		}
-
-		switch s.c {
-		case '{':
-			return TLBRACE, []byte{'{'}, nil
-		case '}':
-			return TRBRACE, []byte{'}'}, nil
-		case '[':
-			return TLBRACKET, []byte{'['}, nil
-		case ']':
-			return TRBRACKET, []byte{']'}, nil
-		case ':':
-			return TCOLON, []byte{':'}, nil
-		case ',':
-			return TCOMMA, []byte{','}, nil
-		case '"':
-			return s.scanString()
-		case 't':
-			return s.scanTrue()
-		case 'f':
-			return s.scanFalse()
-		case 'n':
-			return s.scanNull()
-		}
-
-		if (s.c >= '0' && s.c <= '9') || s.c == '-' {
-			return s.scanNumber()
+	s.depth = len(s.frames)
+	tok, b, err := s.scan()
+	if err == io.EOF && len(s.frames) > 0 {
+		return 0, nil, io.ErrUnexpectedEOF
+	} else if err != nil {
+		return 0, nil, err
+	} else if (tok == TLBRACE || tok == TLBRACKET) && s.maxdepth > 0 && len(s.frames) >= s.maxdepth {
+		return 0, nil, &SyntaxError{
+			Msg:      fmt.Sprintf("Exceeded the maximum nesting depth of %d", s.maxdepth),
+			Position: s.tokpos,
 		}
 	}
+	s.visit(tok, b)
+	return tok, b, nil
 }
 
 // Unscan adds a token and byte array back onto the buffer to be read
-// on the next call to Scan().
+// on the next call to Scan(). The token must be the last scanned token.
 func (s *scanner) Unscan(tok int, b []byte) {
 	s.tmp.tok = tok
 	s.tmp.b = b
-	s.pos--
+	s.tmp.pos, s.tmp.depth = s.tokpos, s.depth
 }
 
-// scanNumber reads a JSON number from the reader.
-func (s *scanner) scanNumber() (int, []byte, error) {
-	var n int
-
-	if s.c == '-' {
-		s.scratch[n] = '-'
-		n++
-		if err := s.read(); err != nil {
-			return 0, nil, err
-		}
-	}
-
-	// Read whole number.
-	if err := s.scanDigits(&n); err == io.EOF {
-		return TNUMBER, s.scratch[0:n], nil
-	} else if err != nil {
-		return 0, nil, err
-	}
-	n++
-
-	// Read period.
-	if err := s.read(); err == io.EOF {
-		return TNUMBER, s.scratch[0:n], nil
-	} else if err != nil {
-		return 0, nil, err
-	} else if s.c != '.' {
-		s.unread()
-		//  We can't just return.  The number could be 1e-1
-		return s.scanScientific(n)
+// ReadString reads a token into a string variable.
+func (s *scanner) ReadString(target *string) error {
+	tok, b, err := s.Scan()
+	if err != nil {
+		return err
 	}
-	s.scratch[n] = '.'
-	n++
-
-	if err := s.read(); err != nil {
-		return 0, nil, err
+	switch tok {
+	case TSTRING:
+		*target = string(b)
+	case TNULL:
+		*target = ""
+	case TNUMBER, TTRUE, TFALSE:
+		if s.strict {
+			return s.TypeError(tok, b, "string")
+		}
+		*target = ""
+	default:
+		return s.Unexpected(tok, b, "string")
 	}
+	return nil
+}
 
-	// Read fraction.
-	if err := s.scanDigits(&n); err == io.EOF {
-		return TNUMBER, s.scratch[0:n], nil
-	} else if err != nil {
-		return 0, nil, err
+// ReadInt reads a token into an int variable.
+func (s *scanner) ReadInt(target *int) error {
+	n, err := s.readInt("int", 0)
+	if err != nil {
+		return err
 	}
-	n++
-
-	return s.scanScientific(n)
+	*target = int(n)
+	return nil
 }
 
-func (s *scanner) scanScientific(n int) (int, []byte, error) {
-	// Read scientific
-	if err := s.read(); err == io.EOF {
-		return TNUMBER, s.scratch[0:n], nil
-	} else if err != nil {
-		return 0, nil, err
-	} else if s.c != 'e' && s.c != 'E' {
-		s.unread()
-		return TNUMBER, s.scratch[0:n], nil
+// ReadInt8 reads a token into an int8 variable.
+func (s *scanner) ReadInt8(target *int8) error {
+	n, err := s.readInt("int8", 8)
+	if err != nil {
+		return err
 	}
-	s.scratch[n] = 'e'
-	n++
+	*target = int8(n)
+	return nil
+}
 
-	// Read sign
-	if err := s.read(); err == io.EOF {
-		return TNUMBER, s.scratch[0:n], nil
-	} else if err != nil {
-		return 0, nil, err
-	} else if s.c != '-' && s.c != '+' {
-		s.unread()
-	} else if s.c == '-' { // don't bother adding the +
-		s.scratch[n] = '-'
-		n++
+// ReadInt16 reads a token into an int16 variable.
+func (s *scanner) ReadInt16(target *int16) error {
+	n, err := s.readInt("int16", 16)
+	if err != nil {
+		return err
 	}
+	*target = int16(n)
+	return nil
+}
 
-	if err := s.read(); err != nil {
-		return 0, nil, err
+// ReadInt32 reads a token into an int32 variable.
+func (s *scanner) ReadInt32(target *int32) error {
+	n, err := s.readInt("int32", 32)
+	if err != nil {
+		return err
 	}
+	*target = int32(n)
+	return nil
+}
 
-	// Read whole number.
-	if err := s.scanDigits(&n); err == io.EOF {
-		return TNUMBER, s.scratch[0:n], nil
-	} else if err != nil {
-		fmt.Println("Bad?")
-		return 0, nil, err
+// ReadInt64 reads a token into an int64 variable.
+func (s *scanner) ReadInt64(target *int64) error {
+	n, err := s.readInt("int64", 64)
+	if err != nil {
+		return err
 	}
-	n++
-
-	return TNUMBER, s.scratch[0:n], nil
+	*target = n
+	return nil
 }
 
-// scanDigits reads a series of digits from the reader.
-func (s *scanner) scanDigits(n *int) error {
-	for {
-		if s.c >= '0' && s.c <= '9' {
-			s.scratch[*n] = byte(s.c)
-			(*n)++
-			if err := s.read(); err != nil {
-				return err
-			}
-		} else {
-			s.unread()
-			(*n)--
-			return nil
-		}
+// ReadUint reads a token into an uint variable.
+func (s *scanner) ReadUint(target *uint) error {
+	n, err := s.readUint("uint", 0)
+	if err != nil {
+		return err
 	}
+	*target = uint(n)
+	return nil
 }
 
-// scanString reads a quoted JSON string from the reader.
-func (s *scanner) scanString() (int, []byte, error) {
-	var overflow []byte
-
-	var n int
-	for {
-		if err := s.read(); err != nil {
-			return 0, nil, err
-		}
-		switch s.c {
-		case '\\':
-			if err := s.read(); err != nil {
-				return 0, nil, err
-			}
-			switch s.c {
-			case '"':
-				s.scratch[n] = '"'
-				n++
-			case '\\':
-				s.scratch[n] = '\\'
-				n++
-			case '/':
-				s.scratch[n] = '/'
-				n++
-			case 'b':
-				s.scratch[n] = '\b'
-				n++
-			case 'f':
-				s.scratch[n] = '\f'
-				n++
-			case 'n':
-				s.scratch[n] = '\n'
-				n++
-			case 'r':
-				s.scratch[n] = '\r'
-				n++
-			case 't':
-				s.scratch[n] = '\t'
-				n++
-			case 'u':
-				numeric := make([]byte, 4)
-				numericCount := 0
-			unicode_loop:
-				for {
-					if err := s.read(); err != nil {
-						return 0, nil, err
-					}
-					switch {
-					case s.c >= '0' && s.c <= '9' || s.c >= 'a' && s.c <= 'f' || s.c >= 'A' && s.c <= 'F':
-						numeric[numericCount] = byte(s.c)
-						numericCount++
-						if numericCount == 4 {
-							var i int64
-							var err error
-							if i, err = strconv.ParseInt(string(numeric), 16, 32); err != nil {
-								return 0, nil, err
-							}
-							if i < utf8.RuneSelf {
-								s.scratch[n] = byte(i)
-								n++
-							} else {
-								encoded := utf8.EncodeRune(s.scratch[n:], rune(i))
-								n += encoded
-							}
-							break unicode_loop
-						}
-					default:
-						s.unread()
-						return 0, nil, fmt.Errorf("Unexpected symbol in unicode escape: %c", s.c)
-					}
-				}
-			default:
-				return 0, nil, fmt.Errorf("Invalid escape character: \\%c", s.c)
-			}
-
-		case '"':
-			if len(overflow) == 0 {
-				return TSTRING, s.scratch[0:n], nil
-			}
-			overflow = append(overflow, s.scratch[0:n]...)
-			return TSTRING, overflow, nil
-
-		default:
-			if s.c < utf8.RuneSelf {
-				if n == bufSize {
-					overflow = append(overflow, s.scratch[0:n]...)
-					n = 0
-				}
-				s.scratch[n] = byte(s.c)
-				n++
-			} else {
-				n += utf8.EncodeRune(s.scratch[n:], s.c)
-			}
-		}
+// ReadUint8 reads a token into an uint8 variable.
+func (s *scanner) ReadUint8(target *uint8) error {
+	n, err := s.readUint("uint8", 8)
+	if err != nil {
+		return err
 	}
+	*target = uint8(n)
+	return nil
 }
 
-// scanTrue reads the "true" token.
-func (s *scanner) scanTrue() (int, []byte, error) {
-	if err := s.expect('r'); err != nil {
-		return 0, nil, err
-	}
-	if err := s.expect('u'); err != nil {
-		return 0, nil, err
-	}
-	if err := s.expect('e'); err != nil {
-		return 0, nil, err
+// ReadUint16 reads a token into an uint16 variable.
+func (s *scanner) ReadUint16(target *uint16) error {
+	n, err := s.readUint("uint16", 16)
+	if err != nil {
+		return err
 	}
-	return TTRUE, nil, nil
+	*target = uint16(n)
+	return nil
 }
 
-// scanFalse reads the "false" token.
-func (s *scanner) scanFalse() (int, []byte, error) {
-	if err := s.expect('a'); err != nil {
-		return 0, nil, err
-	}
-	if err := s.expect('l'); err != nil {
-		return 0, nil, err
-	}
-	if err := s.expect('s'); err != nil {
-		return 0, nil, err
-	}
-	if err := s.expect('e'); err != nil {
-		return 0, nil, err
+// ReadUint32 reads a token into an uint32 variable.
+func (s *scanner) ReadUint32(target *uint32) error {
+	n, err := s.readUint("uint32", 32)
+	if err != nil {
+		return err
 	}
-	return TFALSE, nil, nil
+	*target = uint32(n)
+	return nil
 }
 
-// scanNull reads the "null" token.
-func (s *scanner) scanNull() (int, []byte, error) {
-	if err := s.expect('u'); err != nil {
-		return 0, nil, err
-	}
-	if err := s.expect('l'); err != nil {
-		return 0, nil, err
-	}
-	if err := s.expect('l'); err != nil {
-		return 0, nil, err
+// ReadUint64 reads a token into an uint64 variable.
+func (s *scanner) ReadUint64(target *uint64) error {
+	n, err := s.readUint("uint64", 64)
+	if err != nil {
+		return err
 	}
-	return TNULL, nil, nil
+	*target = n
+	return nil
 }
 
-// ReadString reads a token into a string variable.
-func (s *scanner) ReadString(target *string) error {
-	tok, b, err := s.Scan()
+// ReadUintptr reads a token into an uintptr variable.
+func (s *scanner) ReadUintptr(target *uintptr) error {
+	n, err := s.readUint("uintptr", 0)
 	if err != nil {
 		return err
 	}
-	switch tok {
-	case TSTRING:
-		*target = string(b)
-	case TNUMBER, TTRUE, TFALSE, TNULL:
-		*target = ""
-	default:
-		return fmt.Errorf("Unexpected %s at %d: %s; expected string", TokenName(tok), s.pos, string(b))
-	}
+	*target = uintptr(n)
 	return nil
 }
 
-// ReadInt reads a token into an int variable.
-func (s *scanner) ReadInt(target *int) error {
-	tok, b, err := s.Scan()
+// ReadFloat32 reads a token into a float32 variable.
+func (s *scanner) ReadFloat32(target *float32) error {
+	n, err := s.readFloat("float32", 32)
 	if err != nil {
 		return err
 	}
-	switch tok {
-	case TNUMBER:
-		n, _ := strconv.ParseInt(string(b), 10, 64)
-		*target = int(n)
-	case TSTRING, TTRUE, TFALSE, TNULL:
-		*target = 0
-	default:
-		return fmt.Errorf("Unexpected %s at %d: %s; expected number", TokenName(tok), s.pos, string(b))
-	}
+	*target = float32(n)
 	return nil
 }
 
-// ReadInt64 reads a token into an int64 variable.
-func (s *scanner) ReadInt64(target *int64) error {
-	tok, b, err := s.Scan()
+// ReadFloat64 reads a token into a float64 variable.
+func (s *scanner) ReadFloat64(target *float64) error {
+	n, err := s.readFloat("float64", 64)
 	if err != nil {
 		return err
 	}
-	switch tok {
-	case TNUMBER:
-		n, _ := strconv.ParseInt(string(b), 10, 64)
-		*target = n
-	case TSTRING, TTRUE, TFALSE, TNULL:
-		*target = 0
-	default:
-		return fmt.Errorf("Unexpected %s at %d: %s; expected number", TokenName(tok), s.pos, string(b))
-	}
+	*target = n
 	return nil
 }
 
-// ReadUint reads a token into an uint variable.
-func (s *scanner) ReadUint(target *uint) error {
+// readInt reads a token into a signed integer which fits in a given number
+// of bits. A bit size of zero is the size of an int. Numbers which are out
+// of range or are not integers return an error.
+func (s *scanner) readInt(typ string, bitSize int) (int64, error) {
 	tok, b, err := s.Scan()
 	if err != nil {
-		return err
+		return 0, err
 	}
 	switch tok {
 	case TNUMBER:
-		n, _ := strconv.ParseUint(string(b), 10, 64)
-		*target = uint(n)
-	case TSTRING, TTRUE, TFALSE, TNULL:
-		*target = 0
-	default:
-		return fmt.Errorf("Unexpected %s at %d: %s; expected number", TokenName(tok), s.pos, string(b))
+		n, err := strconv.ParseInt(string(b), 10, bitSize)
+		if err != nil {
+			return 0, s.TypeError(TNUMBER, b, typ)
+		}
+		return n, nil
+	case TNULL:
+		return 0, nil
+	case TSTRING, TTRUE, TFALSE:
+		if s.strict {
+			return 0, s.TypeError(tok, b, typ)
+		}
+		return 0, nil
 	}
-	return nil
+	return 0, s.Unexpected(tok, b, "number")
 }
 
-// ReadUint64 reads a token into an uint64 variable.
-func (s *scanner) ReadUint64(target *uint64) error {
+// readUint reads a token into an unsigned integer which fits in a given
+// number of bits. A bit size of zero is the size of a uint. Numbers which
+// are out of range or are not integers return an error.
+func (s *scanner) readUint(typ string, bitSize int) (uint64, error) {
 	tok, b, err := s.Scan()
 	if err != nil {
-		return err
+		return 0, err
 	}
 	switch tok {
 	case TNUMBER:
-		n, _ := strconv.ParseUint(string(b), 10, 64)
-		*target = n
-	case TSTRING, TTRUE, TFALSE, TNULL:
-		*target = 0
-	default:
-		return fmt.Errorf("Unexpected %s at %d: %s; expected number", TokenName(tok), s.pos, string(b))
+		n, err := strconv.ParseUint(string(b), 10, bitSize)
+		if err != nil {
+			return 0, s.TypeError(TNUMBER, b, typ)
+		}
+		return n, nil
+	case TNULL:
+		return 0, nil
+	case TSTRING, TTRUE, TFALSE:
+		if s.strict {
+			return 0, s.TypeError(tok, b, typ)
+		}
+		return 0, nil
 	}
-	return nil
+	return 0, s.Unexpected(tok, b, "number")
 }
 
-// ReadFloat32 reads a token into a float32 variable.
-func (s *scanner) ReadFloat32(target *float32) error {
+// readFloat reads a token into a floating point number which fits in a
+// given number of bits. Numbers which are out of range return an error.
+func (s *scanner) readFloat(typ string, bitSize int) (float64, error) {
 	tok, b, err := s.Scan()
 	if err != nil {
-		return err
+		return 0, err
 	}
 	switch tok {
 	case TNUMBER:
-		n, _ := strconv.ParseFloat(string(b), 32)
-		*target = float32(n)
-	case TSTRING, TTRUE, TFALSE, TNULL:
-		*target = 0
-	default:
-		return fmt.Errorf("Unexpected %s at %d: %s; expected number", TokenName(tok), s.pos, string(b))
+		n, err := strconv.ParseFloat(string(b), bitSize)
+		if err != nil {
+			return 0, s.TypeError(TNUMBER, b, typ)
+		}
+		return n, nil
+	case TNULL:
+		return 0, nil
+	case TSTRING, TTRUE, TFALSE:
+		if s.strict {
+			return 0, s.TypeError(tok, b, typ)
+		}
+		return 0, nil
 	}
-	return nil
+	return 0, s.Unexpected(tok, b, "number")
 }
 
-// ReadFloat64 reads a token into a float64 variable.
-func (s *scanner) ReadFloat64(target *float64) error {
+// Unexpected returns a syntax error for the last scanned token. The expected
+// token is omitted from the message when it is blank.
+func (s *scanner) Unexpected(tok int, b []byte, expected string) error {
+	return &SyntaxError{Expected: expected, Actual: describe(tok, b), Path: s.Path(), Position: s.tokpos}
+}
+
+// TypeError returns the error for the last scanned token when its value
+// cannot be stored in a variable of the given type.
+func (s *scanner) TypeError(tok int, b []byte, typ string) error {
+	return &UnmarshalTypeError{Value: describe(tok, b), Type: typ, Path: s.Path(), Position: s.tokpos}
+}
+
+// UnknownField returns the error for an object key which does not match a
+// field in strict mode. The error has the position of the last key read.
+func (s *scanner) UnknownField(key string) error {
+	pos := s.tokpos
+	if len(s.frames) > 0 && !s.frames[len(s.frames)-1].array {
+		pos = s.frames[len(s.frames)-1].keypos
+	}
+	return &SyntaxError{Msg: "Unknown field " + strconv.Quote(key), Actual: "field " + strconv.Quote(key), Path: s.Path(), Position: pos}
+}
+
+// ReadBool reads a token into a boolean variable.
+func (s *scanner) ReadBool(target *bool) error {
 	tok, b, err := s.Scan()
 	if err != nil {
 		return err
 	}
 	switch tok {
-	case TNUMBER:
-		n, _ := strconv.ParseFloat(string(b), 64)
-		*target = n
-	case TSTRING, TTRUE, TFALSE, TNULL:
-		*target = 0
+	case TTRUE:
+		*target = true
+	case TFALSE, TNULL:
+		*target = false
+	case TSTRING, TNUMBER:
+		if s.strict {
+			return s.TypeError(tok, b, "bool")
+		}
+		*target = false
 	default:
-		return fmt.Errorf("Unexpected %s at %d: %s; expected number", TokenName(tok), s.pos, string(b))
+		return s.Unexpected(tok, b, "true or false")
 	}
 	return nil
 }
 
-// ReadBool reads a token into a boolean variable.
-func (s *scanner) ReadBool(target *bool) error {
+// ReadBytes reads a base64 encoded string token into a byte slice variable.
+func (s *scanner) ReadBytes(target *[]byte) error {
 	tok, b, err := s.Scan()
 	if err != nil {
 		return err
 	}
 	switch tok {
-	case TTRUE:
-		*target = true
-	case TFALSE, TSTRING, TNUMBER, TNULL:
-		*target = false
+	case TSTRING:
+		buf := make([]byte, base64.StdEncoding.DecodedLen(len(b)))
+		n, err := base64.StdEncoding.Decode(buf, b)
+		if err != nil {
+			return s.TypeError(tok, b, "[]byte")
+		}
+		*target = buf[:n]
+	case TNULL:
+		*target = nil
 	default:
-		return fmt.Errorf("Unexpected %s at %d: %s; expected number", TokenName(tok), s.pos, string(b))
+		return s.Unexpected(tok, b, "string")
 	}
 	return nil
 }
@@ -561,7 +465,7 @@ func (s *scanner) ReadMap(target *map[string]interface{}) error {
 		*target = nil
 		return nil
 	} else if tok != TLBRACE {
-		return fmt.Errorf("Unexpected %s at %d: %s; expected '{'", TokenName(tok), s.Pos(), string(b))
+		return s.Unexpected(tok, b, "'{'")
 	}
 
 	// Create a new map.
@@ -580,15 +484,17 @@ func (s *scanner) ReadMap(target *map[string]interface{}) error {
 			return nil
 		} else if tok == TCOMMA {
 			if index == 0 {
-				return fmt.Errorf("Unexpected comma at %d", s.Pos())
+				return s.Unexpected(tok, b, "string")
 			}
 			if tok, b, err = s.Scan(); err != nil {
 				return err
 			}
+		} else if index > 0 {
+			return s.Unexpected(tok, b, "comma or '}'")
 		}
 
 		if tok != TSTRING {
-			return fmt.Errorf("Unexpected %s at %d: %s; expected '{' or string", TokenName(tok), s.Pos(), string(b))
+			return s.Unexpected(tok, b, "string")
 		} else {
 			key = string(b)
 		}
@@ -597,54 +503,25 @@ func (s *scanner) ReadMap(target *map[string]interface{}) error {
 		if tok, b, err := s.Scan(); err != nil {
 			return err
 		} else if tok != TCOLON {
-			return fmt.Errorf("Unexpected %s at %d: %s; expected colon", TokenName(tok), s.Pos(), string(b))
+			return s.Unexpected(tok, b, "colon")
 		}
 
-		// Read the next token.
-		tok, b, err = s.Scan()
-		if err != nil {
+		// Read the value.
+		var value interface{}
+		if err := s.ReadInterface(&value); err != nil {
 			return err
 		}
-		switch tok {
-		case TSTRING:
-			v[key] = string(b)
-		case TNUMBER:
-			v[key], _ = strconv.ParseFloat(string(b), 64)
-		case TTRUE:
-			v[key] = true
-		case TFALSE:
-			v[key] = false
-		case TNULL:
-			v[key] = nil
-		case TLBRACE:
-			s.Unscan(tok, b)
-			m := make(map[string]interface{})
-			if err := s.ReadMap(&m); err != nil {
-				return err
-			}
-			v[key] = m
-		case TLBRACKET:
-			s.Unscan(tok, b)
-			var arr []interface{}
-			if err := s.ReadArray(&arr); err != nil {
-				return err
-			}
-			v[key] = arr
-		default:
-			return fmt.Errorf("Unexpected %s at %d: %s", TokenName(tok), s.Pos(), string(b))
-		}
+		v[key] = value
 
 		index++
 	}
-
-	return nil
 }
 
 func (s *scanner) ReadArray(target *[]interface{}) error {
 	if tok, b, err := s.Scan(); err != nil {
 		return err
 	} else if tok != TLBRACKET {
-		return fmt.Errorf("Unexpected %s at %d: %s; expected '['", TokenName(tok), s.Pos(), string(b))
+		return s.Unexpected(tok, b, "'['")
 	}
 
 	index := 0
@@ -656,45 +533,255 @@ func (s *scanner) ReadArray(target *[]interface{}) error {
 			return nil
 		} else if tok == TCOMMA {
 			if index == 0 {
-				return fmt.Errorf("Unexpected comma in array at %d", s.Pos())
+				return s.Unexpected(tok, b, "value")
 			}
 			if tok, b, err = s.Scan(); err != nil {
 				return err
 			}
+		} else if index > 0 {
+			return s.Unexpected(tok, b, "comma or ']'")
 		}
 
+		s.Unscan(tok, b)
+
 		var v interface{}
-		switch tok {
-		case TSTRING:
-			v = string(b)
-		case TNUMBER:
-			v, _ = strconv.ParseFloat(string(b), 64)
-		case TTRUE:
-			v = true
-		case TFALSE:
-			v = false
-		case TNULL:
-			v = nil
-		case TLBRACE:
-			s.Unscan(tok, b)
-			m := make(map[string]interface{})
-			if err := s.ReadMap(&m); err != nil {
+		if err := s.ReadInterface(&v); err != nil {
+			return err
+		}
+		*target = append(*target, v)
+
+		index++
+	}
+}
+
+// ReadInterface reads the next value into an interface variable. Values are
+// read into the same types as encoding/json: string, float64, bool, nil,
+// []interface{} and map[string]interface{}.
+func (s *scanner) ReadInterface(target *interface{}) error {
+	tok, b, err := s.Scan()
+	if err != nil {
+		return err
+	}
+	switch tok {
+	case TSTRING:
+		*target = string(b)
+	case TNUMBER:
+		n, err := strconv.ParseFloat(string(b), 64)
+		if err != nil {
+			return s.TypeError(TNUMBER, b, "float64")
+		}
+		*target = n
+	case TTRUE:
+		*target = true
+	case TFALSE:
+		*target = false
+	case TNULL:
+		*target = nil
+	case TLBRACE:
+		s.Unscan(tok, b)
+		m := make(map[string]interface{})
+		if err := s.ReadMap(&m); err != nil {
+			return err
+		}
+		*target = m
+	case TLBRACKET:
+		s.Unscan(tok, b)
+		arr := make([]interface{}, 0)
+		if err := s.ReadArray(&arr); err != nil {
+			return err
+		}
+		*target = arr
+	default:
+		return s.Unexpected(tok, b, "value")
+	}
+	return nil
+}
+
+// ReadRaw reads the next value and stores its compact JSON encoding in target.
+func (s *scanner) ReadRaw(target *[]byte) error {
+	b, err := s.appendValue(nil)
+	if err != nil {
+		return err
+	}
+	*target = b
+	return nil
+}
+
+// Skip reads the next value and discards it.
+func (s *scanner) Skip() error {
+	tok, b, err := s.Scan()
+	if err != nil {
+		return err
+	}
+	switch tok {
+	case TSTRING, TNUMBER, TTRUE, TFALSE, TNULL:
+		return nil
+	case TLBRACE:
+		for index := 0; ; index++ {
+			tok, b, err := s.Scan()
+			if err != nil {
 				return err
+			} else if tok == TRBRACE {
+				return nil
+			} else if tok == TCOMMA {
+				if index == 0 {
+					return s.Unexpected(tok, b, "string")
+				}
+				if tok, b, err = s.Scan(); err != nil {
+					return err
+				}
+			} else if index > 0 {
+				return s.Unexpected(tok, b, "comma or '}'")
+			}
+
+			if tok != TSTRING {
+				return s.Unexpected(tok, b, "string")
+			}
+			if tok, b, err := s.Scan(); err != nil {
+				return err
+			} else if tok != TCOLON {
+				return s.Unexpected(tok, b, "colon")
+			}
+			if err := s.Skip(); err != nil {
+				return err
+			}
+		}
+	case TLBRACKET:
+		for index := 0; ; index++ {
+			tok, b, err := s.Scan()
+			if err != nil {
+				return err
+			} else if tok == TRBRACKET {
+				return nil
+			} else if tok == TCOMMA {
+				if index == 0 {
+					return s.Unexpected(tok, b, "value")
+				}
+				if tok, b, err = s.Scan(); err != nil {
+					return err
+				}
+			} else if index > 0 {
+				return s.Unexpected(tok, b, "comma or ']'")
 			}
-			v = m
-		case TLBRACKET:
 			s.Unscan(tok, b)
-			var arr []interface{}
-			if err := s.ReadArray(&arr); err != nil {
+
+			if err := s.Skip(); err != nil {
 				return err
 			}
-			v = arr
-		default:
-			return fmt.Errorf("Unexpected %s at %d: %s", TokenName(tok), s.Pos(), string(b))
 		}
-		*target = append(*target, v)
+	}
+	return s.Unexpected(tok, b, "value")
+}
 
-		index++
+// End returns an error if there are any tokens left to read.
+func (s *scanner) End() error {
+	tok, b, err := s.Scan()
+	if err == io.EOF {
+		return nil
+	} else if err != nil {
+		return err
 	}
-	return nil
+	return s.Unexpected(tok, b, "end of input")
+}
+
+// appendValue reads the next value and appends its JSON encoding to b.
+func (s *scanner) appendValue(b []byte) ([]byte, error) {
+	tok, tokval, err := s.Scan()
+	if err != nil {
+		return nil, err
+	}
+	switch tok {
+	case TSTRING:
+		return appendString(b, tokval), nil
+	case TNUMBER:
+		return append(b, tokval...), nil
+	case TTRUE:
+		return append(b, "true"...), nil
+	case TFALSE:
+		return append(b, "false"...), nil
+	case TNULL:
+		return append(b, "null"...), nil
+	case TLBRACE:
+		b = append(b, '{')
+		for index := 0; ; index++ {
+			tok, tokval, err := s.Scan()
+			if err != nil {
+				return nil, err
+			} else if tok == TRBRACE {
+				return append(b, '}'), nil
+			} else if tok == TCOMMA {
+				if index == 0 {
+					return nil, s.Unexpected(tok, tokval, "string")
+				}
+				b = append(b, ',')
+				if tok, tokval, err = s.Scan(); err != nil {
+					return nil, err
+				}
+			} else if index > 0 {
+				return nil, s.Unexpected(tok, tokval, "comma or '}'")
+			}
+
+			if tok != TSTRING {
+				return nil, s.Unexpected(tok, tokval, "string")
+			}
+			b = appendString(b, tokval)
+
+			if tok, tokval, err := s.Scan(); err != nil {
+				return nil, err
+			} else if tok != TCOLON {
+				return nil, s.Unexpected(tok, tokval, "colon")
+			}
+			b = append(b, ':')
+
+			if b, err = s.appendValue(b); err != nil {
+				return nil, err
+			}
+		}
+	case TLBRACKET:
+		b = append(b, '[')
+		for index := 0; ; index++ {
+			tok, tokval, err := s.Scan()
+			if err != nil {
+				return nil, err
+			} else if tok == TRBRACKET {
+				return append(b, ']'), nil
+			} else if tok == TCOMMA {
+				if index == 0 {
+					return nil, s.Unexpected(tok, tokval, "value")
+				}
+				b = append(b, ',')
+				if tok, tokval, err = s.Scan(); err != nil {
+					return nil, err
+				}
+			} else if index > 0 {
+				return nil, s.Unexpected(tok, tokval, "comma or ']'")
+			}
+			s.Unscan(tok, tokval)
+
+			if b, err = s.appendValue(b); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return nil, s.Unexpected(tok, tokval, "value")
+}
+
+// appendString appends the JSON encoding of an unescaped string to b.
+func appendString(b []byte, v []byte) []byte {
+	b = append(b, '"')
+	for _, c := range v {
+		switch {
+		case c == '"' || c == '\\':
+			b = append(b, '\\', c)
+		case c == '\n':
+			b = append(b, '\\', 'n')
+		case c == '\r':
+			b = append(b, '\\', 'r')
+		case c < 0x20:
+			b = append(b, '\\', 'u', '0', '0', hex[c>>4], hex[c&0xF])
+		default:
+			b = append(b, c)
+		}
+	}
+	return append(b, '"')
 }
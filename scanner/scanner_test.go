@@ -2,9 +2,13 @@ package scanner
 
 import (
 	"bytes"
+	"errors"
 	"io"
+	"io/ioutil"
+	"path/filepath"
 	"strings"
 	"testing"
+	"testing/iotest"
 
 	"github.com/stretchr/testify/assert"
 	"strconv"
@@ -166,6 +170,43 @@ func TestReadInt64(t *testing.T) {
 	assert.Equal(t, v, -100)
 }
 
+// Ensures that sized integers can be read into fields.
+func TestReadSizedInts(t *testing.T) {
+	var i8 int8
+	var i16 int16
+	var i32 int32
+	var u8 uint8
+	var u16 uint16
+	var u32 uint32
+	var ptr uintptr
+	s := NewScanner(strings.NewReader(`-128 -32768 -2147483648 255 65535 4294967295 1024`))
+	assert.NoError(t, s.ReadInt8(&i8))
+	assert.NoError(t, s.ReadInt16(&i16))
+	assert.NoError(t, s.ReadInt32(&i32))
+	assert.NoError(t, s.ReadUint8(&u8))
+	assert.NoError(t, s.ReadUint16(&u16))
+	assert.NoError(t, s.ReadUint32(&u32))
+	assert.NoError(t, s.ReadUintptr(&ptr))
+	assert.Equal(t, i8, int8(-128))
+	assert.Equal(t, i16, int16(-32768))
+	assert.Equal(t, i32, int32(-2147483648))
+	assert.Equal(t, u8, uint8(255))
+	assert.Equal(t, u16, uint16(65535))
+	assert.Equal(t, u32, uint32(4294967295))
+	assert.Equal(t, ptr, uintptr(1024))
+}
+
+// Ensures that numbers which overflow their variable return an error.
+func TestReadIntOverflow(t *testing.T) {
+	var i8 int8
+	var u32 uint32
+	var f32 float32
+	assert.Error(t, NewScanner(strings.NewReader(`128`)).ReadInt8(&i8))
+	assert.Error(t, NewScanner(strings.NewReader(`-1`)).ReadUint32(&u32))
+	assert.Error(t, NewScanner(strings.NewReader(`1.5`)).ReadInt8(&i8))
+	assert.Error(t, NewScanner(strings.NewReader(`1e39`)).ReadFloat32(&f32))
+}
+
 // Ensures that a uint can be read into a field.
 func TestReadUint(t *testing.T) {
 	var v uint
@@ -206,6 +247,17 @@ func TestReadBoolTrue(t *testing.T) {
 	assert.Equal(t, v, true)
 }
 
+// Ensures that a base64 string can be read into a byte slice.
+func TestReadBytes(t *testing.T) {
+	var v []byte
+	s := NewScanner(strings.NewReader(`"Zm9vYmFy" null "!"`))
+	assert.NoError(t, s.ReadBytes(&v))
+	assert.Equal(t, string(v), "foobar")
+	assert.NoError(t, s.ReadBytes(&v))
+	assert.Nil(t, v)
+	assert.Error(t, s.ReadBytes(&v))
+}
+
 // Ensures whitespace between tokens are ignored.
 func TestScanIgnoreWhitespace(t *testing.T) {
 	s := NewScanner(strings.NewReader(" 100 true false "))
@@ -255,6 +307,323 @@ func TestReadMapWithArray(t *testing.T) {
 	assert.Equal(t, 42.0, arr[1].(float64))
 }
 
+// Ensures that any value can be read into an interface.
+func TestReadInterface(t *testing.T) {
+	var v interface{}
+	s := NewScanner(strings.NewReader(`"foo" 12 true null [] {"bar":[1]}`))
+	assert.NoError(t, s.ReadInterface(&v))
+	assert.Equal(t, v, "foo")
+	assert.NoError(t, s.ReadInterface(&v))
+	assert.Equal(t, v, float64(12))
+	assert.NoError(t, s.ReadInterface(&v))
+	assert.Equal(t, v, true)
+	assert.NoError(t, s.ReadInterface(&v))
+	assert.Nil(t, v)
+	assert.NoError(t, s.ReadInterface(&v))
+	assert.Equal(t, v, []interface{}{})
+	assert.NoError(t, s.ReadInterface(&v))
+	assert.Equal(t, v, map[string]interface{}{"bar": []interface{}{float64(1)}})
+}
+
+// Ensures that a value can be read as raw JSON.
+func TestReadRaw(t *testing.T) {
+	var b []byte
+	s := NewScanner(strings.NewReader(`{"foo": ["bar\n\u0001", 1.5e3, true, false, null], "baz": {}} 12`))
+	assert.NoError(t, s.ReadRaw(&b))
+	assert.Equal(t, string(b), `{"foo":["bar\n\u0001",1.5e3,true,false,null],"baz":{}}`)
+	assert.NoError(t, s.ReadRaw(&b))
+	assert.Equal(t, string(b), `12`)
+}
+
+// Ensures that values of the wrong type are errors in strict mode.
+func TestReadStrict(t *testing.T) {
+	var n int
+	s := NewScanner(strings.NewReader(`"12" null`))
+	s.SetStrict(true)
+	assert.EqualError(t, s.ReadInt(&n), `Cannot read string "12" into int at line 1, column 1`)
+	assert.NoError(t, s.ReadInt(&n))
+}
+
+// Ensures that nested values are skipped.
+func TestSkip(t *testing.T) {
+	var v string
+	s := NewScanner(strings.NewReader(`{"a":[1,{"b":null}],"c":"d"} "foo"`))
+	assert.NoError(t, s.Skip())
+	assert.NoError(t, s.ReadString(&v))
+	assert.Equal(t, v, "foo")
+	assert.Error(t, NewScanner(strings.NewReader(`[1}`)).Skip())
+}
+
+// Ensures that data after the last value is an error.
+func TestEnd(t *testing.T) {
+	assert.NoError(t, NewScanner(strings.NewReader(` `)).End())
+	assert.EqualError(t, NewScanner(strings.NewReader(`1`)).End(), "Unexpected number 1 at line 1, column 1; expected end of input")
+}
+
+// Ensures that type errors have the position and path of the value.
+func TestTypeError(t *testing.T) {
+	var n int8
+	s := NewScanner(strings.NewReader("{\"items\": [\n  {\"price\": 1},\n  {\"price\": 300}]}"))
+	for i := 0; i < 13; i++ {
+		s.Scan()
+	}
+	err := s.ReadInt8(&n)
+	assert.EqualError(t, err, "Cannot read number 300 into int8 at line 3, column 13 in .items[1].price")
+
+	var e *UnmarshalTypeError
+	assert.True(t, errors.As(err, &e))
+	assert.Equal(t, e.Position, Position{Offset: 40, Line: 3, Column: 13})
+	assert.Equal(t, e.Path, ".items[1].price")
+	assert.Equal(t, e.Value, "number 300")
+	assert.Equal(t, e.Type, "int8")
+}
+
+// Ensures that syntax errors have the position and path of the token.
+func TestSyntaxError(t *testing.T) {
+	var v interface{}
+	err := NewScanner(strings.NewReader(`{"a": {"first name": [1, 2 :]}}`)).ReadInterface(&v)
+	assert.EqualError(t, err, `Unexpected colon at line 1, column 28 in .a["first name"][1]; expected comma or ']'`)
+
+	var e *SyntaxError
+	assert.True(t, errors.As(err, &e))
+	assert.Equal(t, e.Offset, 27)
+	assert.Equal(t, e.Actual, "colon")
+	assert.Equal(t, e.Expected, "comma or ']'")
+
+	err = NewScanner(strings.NewReader(`["\x"]`)).ReadInterface(&v)
+	assert.EqualError(t, err, `Invalid escape character "\\x" at line 1, column 4 in [0]`)
+}
+
+// Ensures that unscanning a token restores its position.
+func TestUnscanPosition(t *testing.T) {
+	s := NewScanner(strings.NewReader(`[1, 22]`))
+	s.Scan()
+	s.Scan()
+	s.Scan()
+	tok, b, _ := s.Scan()
+	assert.Equal(t, s.Pos(), 4)
+	s.Unscan(tok, b)
+	s.Scan()
+	assert.Equal(t, s.Pos(), 4)
+	assert.Equal(t, s.Path(), "[1]")
+}
+
+// Ensures that the scanner accepts the valid documents of JSONTestSuite and
+// rejects the invalid ones with a syntax error. Documents which are
+// implementation defined only need to be read without panicking.
+func TestJSONTestSuite(t *testing.T) {
+	paths, err := filepath.Glob("testdata/test_parsing/*.json")
+	assert.NoError(t, err)
+	assert.NotEmpty(t, paths)
+	for _, path := range paths {
+		data, err := ioutil.ReadFile(path)
+		assert.NoError(t, err)
+
+		// Read each document from a reader which returns one byte at a time
+		// to check the runes which are split between reads and from a bytes
+		// scanner.
+		name := filepath.Base(path)
+		err = parse(NewScanner(bytes.NewReader(data)))
+		assert.Equal(t, parse(NewScanner(iotest.OneByteReader(bytes.NewReader(data)))) == nil, err == nil, name)
+		assert.Equal(t, parse(NewBytesScanner(data)) == nil, err == nil, name)
+		switch name[0] {
+		case 'y':
+			assert.NoError(t, err, name)
+		case 'n':
+			var e *SyntaxError
+			if !errors.As(err, &e) && err != io.ErrUnexpectedEOF && err != io.EOF {
+				t.Errorf("%s: expected syntax error, got: %v", name, err)
+			}
+		}
+	}
+}
+
+// parse reads a single JSON document.
+func parse(s Scanner) error {
+	var v interface{}
+	if err := s.ReadInterface(&v); err != nil {
+		return err
+	}
+	return s.End()
+}
+
+// Ensures that surrogate pairs are decoded and unpaired surrogates are replaced.
+func TestScanSurrogates(t *testing.T) {
+	_, b, err := NewScanner(strings.NewReader(`"\ud83d\ude39 \ud800 \udc00x \ud800\n"`)).Scan()
+	assert.NoError(t, err)
+	assert.Equal(t, string(b), "\U0001F639 \uFFFD \uFFFDx \uFFFD\n")
+}
+
+// Ensures that invalid numbers are syntax errors.
+func TestScanInvalidNumber(t *testing.T) {
+	for _, s := range []string{"-", "01", "1.", "1.e1", "1e", "1e+", "-a"} {
+		_, _, err := NewScanner(strings.NewReader(s)).Scan()
+		assert.Error(t, err, s)
+	}
+}
+
+// Ensures that a bytes scanner returns the same tokens as a reader scanner.
+func TestBytesScanner(t *testing.T) {
+	data := "{\"a\": [1, -2.5e+3, true, false, null, \"x\\ty\", \"\\ud83d\\ude39\"],\n \"b\": {}}"
+	r := NewScanner(strings.NewReader(data))
+	s := NewBytesScanner([]byte(data))
+	for {
+		tok, b, err := s.Scan()
+		rtok, rb, rerr := r.Scan()
+		assert.Equal(t, tok, rtok)
+		assert.Equal(t, s.Position(), r.Position())
+		assert.Equal(t, err, rerr)
+		assert.Equal(t, string(b), string(rb))
+		if err != nil {
+			break
+		}
+	}
+}
+
+// Ensures that strings without escapes and numbers are not copied.
+func TestBytesScannerNoCopy(t *testing.T) {
+	data := []byte(`["foo", 12]`)
+	s := NewBytesScanner(data)
+	s.Scan()
+	_, b, _ := s.Scan()
+	assert.True(t, &b[0] == &data[2])
+	s.Scan()
+	_, b, _ = s.Scan()
+	assert.True(t, &b[0] == &data[8])
+}
+
+// Ensures that the end of a string is found within and across words.
+func TestStringEnd(t *testing.T) {
+	for _, tt := range []struct {
+		in    string
+		n     int
+		ascii bool
+	}{
+		{``, 0, true},
+		{`abc"`, 3, true},
+		{`abcdefgh"`, 8, true},
+		{`abcdefghij\n`, 10, true},
+		{"abcdefgh\x01", 8, true},
+		{"abc\x1f", 3, true},
+		{"abc def ghi ", 12, true},
+		{"caf\xc3\xa9 au lait\"", 13, false},
+		{"abcdefg\"\xc3\xa9", 7, true},
+		{"\x7f\x7f\x7f\x7f\x7f\x7f\x7f\x7f", 8, true},
+	} {
+		n, hi := stringEnd([]byte(tt.in))
+		assert.Equal(t, n, tt.n, "%q", tt.in)
+		assert.Equal(t, hi, !tt.ascii, "%q", tt.in)
+	}
+}
+
+// Ensures that tokens which span reads of the buffer are scanned whole.
+func TestScanAcrossBuffer(t *testing.T) {
+	data := `["` + strings.Repeat("x", bufSize-3) + `\u00e9", ` + strings.Repeat("1", bufSize) + `]`
+	s := NewScanner(strings.NewReader(data))
+	s.Scan()
+	_, b, err := s.Scan()
+	assert.NoError(t, err)
+	assert.Equal(t, string(b), strings.Repeat("x", bufSize-3)+"\u00e9")
+	s.Scan()
+	tok, b, err := s.Scan()
+	assert.NoError(t, err)
+	assert.Equal(t, tok, TNUMBER)
+	assert.Equal(t, len(b), bufSize)
+}
+
+// Ensures that strings and numbers of any length are scanned.
+func TestScanLongTokens(t *testing.T) {
+	for _, tt := range []struct {
+		in, out string
+	}{
+		{`"` + strings.Repeat("é", 3*bufSize) + `"`, strings.Repeat("é", 3*bufSize)},
+		{`"` + strings.Repeat(`\n`, 3*bufSize) + `"`, strings.Repeat("\n", 3*bufSize)},
+		{`"` + strings.Repeat(`\u00e9`, bufSize) + `"`, strings.Repeat("é", bufSize)},
+		{strings.Repeat("9", 3*bufSize), strings.Repeat("9", 3*bufSize)},
+		{"0." + strings.Repeat("5", 3*bufSize) + "E+1", "0." + strings.Repeat("5", 3*bufSize) + "e1"},
+	} {
+		for _, s := range []Scanner{NewScanner(strings.NewReader(tt.in)), NewBytesScanner([]byte(tt.in))} {
+			_, b, err := s.Scan()
+			assert.NoError(t, err)
+			assert.Equal(t, string(b), tt.out)
+		}
+	}
+}
+
+// Ensures that tokens larger than the maximum size return an error.
+func TestMaxTokenSize(t *testing.T) {
+	for _, tt := range []struct {
+		in  string
+		err string
+	}{
+		{`["abcdefgh"]`, ""},
+		{`["abcdefghi"]`, "Token exceeds the maximum size of 10 bytes at line 1, column 2 in [0]"},
+		{`["abcdef\n"]`, ""},
+		{`["abcdefg\n"]`, "Token exceeds the maximum size of 10 bytes at line 1, column 2 in [0]"},
+		{`[true, 1234567890]`, ""},
+		{`[true, 12345678901]`, "Token exceeds the maximum size of 10 bytes at line 1, column 8 in [1]"},
+		{`["` + strings.Repeat("x", 4*bufSize) + `"]`, "Token exceeds the maximum size of 10 bytes at line 1, column 2 in [0]"},
+		{`["` + strings.Repeat(`\t`, 4*bufSize) + `"]`, "Token exceeds the maximum size of 10 bytes at line 1, column 2 in [0]"},
+		{`[` + strings.Repeat("1", 4*bufSize) + `]`, "Token exceeds the maximum size of 10 bytes at line 1, column 2 in [0]"},
+	} {
+		for _, s := range []Scanner{NewScanner(strings.NewReader(tt.in)), NewBytesScanner([]byte(tt.in))} {
+			s.SetMaxTokenSize(10)
+			var err error
+			for err == nil {
+				_, _, err = s.Scan()
+			}
+			if tt.err == "" {
+				assert.Equal(t, err, io.EOF)
+			} else if assert.IsType(t, err, &SyntaxError{}) {
+				assert.Equal(t, err.Error(), tt.err)
+			}
+		}
+	}
+}
+
+// Ensures that the buffer does not grow past the maximum token size.
+func TestMaxTokenSizeBuffer(t *testing.T) {
+	s := NewScanner(strings.NewReader(`"` + strings.Repeat("x", 64*bufSize) + `"`))
+	s.SetMaxTokenSize(bufSize)
+	_, _, err := s.Scan()
+	assert.Error(t, err)
+	assert.True(t, cap(s.(*scanner).buf) <= 2*bufSize)
+}
+
+// Ensures that values nested deeper than the maximum depth return an error
+// instead of overflowing the stack.
+func TestMaxDepth(t *testing.T) {
+	deep := strings.Repeat(`[{"a":`, 5000000)
+	for _, read := range []func(s Scanner) error{
+		func(s Scanner) error { var v interface{}; return s.ReadInterface(&v) },
+		func(s Scanner) error { var v []byte; return s.ReadRaw(&v) },
+		func(s Scanner) error { return s.Skip() },
+	} {
+		err := read(NewBytesScanner([]byte(deep)))
+		if assert.IsType(t, err, &SyntaxError{}) {
+			assert.Equal(t, err.(*SyntaxError).Msg, "Exceeded the maximum nesting depth of 10000")
+			assert.Equal(t, err.(*SyntaxError).Offset, len(`[{"a":`)*DefaultMaxDepth/2)
+		}
+	}
+
+	s := NewScanner(strings.NewReader(`[[[1]], [[[2]]]]`))
+	s.SetMaxDepth(3)
+	var v interface{}
+	err := s.ReadInterface(&v)
+	assert.Equal(t, err.Error(), "Exceeded the maximum nesting depth of 3 at line 1, column 11")
+
+	s = NewScanner(strings.NewReader(`[[[1]], [[[2]]]]`))
+	s.SetMaxDepth(4)
+	assert.NoError(t, s.ReadInterface(&v))
+}
+
+// Ensures that object keys match exactly before matching case-insensitively.
+func TestFoldKey(t *testing.T) {
+	assert.Equal(t, FoldKey("name", "Name", "name"), "name")
+	assert.Equal(t, FoldKey("NAME", "Name", "name"), "Name")
+	assert.Equal(t, FoldKey("other", "Name"), "other")
+}
+
 func BenchmarkScanNumber(b *testing.B) {
 	withBuffer(b, "100", func(buf []byte) {
 		s := NewScanner(bytes.NewBuffer(buf))
@@ -0,0 +1,42 @@
+package scanner
+
+import (
+	"encoding/binary"
+	"math/bits"
+)
+
+// Masks for testing the eight bytes of a word at once.
+const (
+	lsb = 0x0101010101010101
+	msb = 0x8080808080808080
+)
+
+// stringEnd returns the index of the first quote, backslash or control
+// character in b or len(b) if there is none. It also returns true if any
+// byte before the index is not ASCII.
+//
+// Eight bytes are tested at a time. A byte of a word is zero when
+// (x - lsb) & ^x & msb has its high bit set and the lowest set bit is always
+// exact, so XOR-ing the word with a repeated character finds that character.
+func stringEnd(b []byte) (int, bool) {
+	var hi uint64
+	i := 0
+	for ; i+8 <= len(b); i += 8 {
+		x := binary.LittleEndian.Uint64(b[i:])
+		q, e := x^(lsb*'"'), x^(lsb*'\\')
+		m := (q-lsb)&^q | (e-lsb)&^e | (x - lsb*0x20)
+		if m &= ^x & msb; m != 0 {
+			n := bits.TrailingZeros64(m) >> 3
+			hi |= x & msb & (1<<(8*n) - 1)
+			return i + n, hi != 0
+		}
+		hi |= x & msb
+	}
+	for ; i < len(b); i++ {
+		if c := b[i]; c == '"' || c == '\\' || c < 0x20 {
+			return i, hi != 0
+		}
+		hi |= uint64(b[i] & 0x80)
+	}
+	return len(b), hi != 0
+}
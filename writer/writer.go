@@ -1,8 +1,10 @@
 package writer
 
 import (
+	"encoding/base64"
 	"encoding/json"
 	"io"
+	"sort"
 	"strconv"
 	"unicode/utf8"
 )
@@ -21,9 +23,10 @@ const (
 var hex = "0123456789abcdef"
 
 type Writer struct {
-	w   io.Writer
-	buf [actualBufSize + 64]byte
-	pos int
+	w        io.Writer
+	buf      [actualBufSize + 64]byte
+	pos      int
+	sortkeys bool
 }
 
 // NewWriter creates a new JSON writer.
@@ -31,6 +34,12 @@ func NewWriter(w io.Writer) *Writer {
 	return &Writer{w: w}
 }
 
+// SetSortKeys sets whether the keys of maps written by WriteMap and
+// WriteInterface are sorted. Otherwise they are written in random order.
+func (w *Writer) SetSortKeys(sortkeys bool) {
+	w.sortkeys = sortkeys
+}
+
 // Flush writes all data in the buffer to the writer.
 func (w *Writer) Flush() error {
 	if w.pos > 0 {
@@ -157,11 +166,54 @@ func (w *Writer) WriteString(v string) error {
 	return nil
 }
 
+// WriteBytes writes a byte slice as a base64 encoded JSON string. The bytes
+// are encoded directly into the buffer in chunks.
+func (w *Writer) WriteBytes(v []byte) error {
+	if err := w.check(); err != nil {
+		return err
+	}
+
+	w.writeByte('"')
+	for len(v) > 0 {
+		// Encode as many 3 byte groups as will fit into the buffer.
+		n := (actualBufSize - w.pos) / 4 * 3
+		if n == 0 {
+			if err := w.Flush(); err != nil {
+				return err
+			}
+			continue
+		} else if n > len(v) {
+			n = len(v)
+		}
+
+		base64.StdEncoding.Encode(w.buf[w.pos:], v[:n])
+		w.pos += base64.StdEncoding.EncodedLen(n)
+		v = v[n:]
+	}
+	w.writeByte('"')
+	return nil
+}
+
 // WriteInt encodes and writes an integer.
 func (w *Writer) WriteInt(v int) error {
 	return w.WriteInt64(int64(v))
 }
 
+// WriteInt8 encodes and writes an 8-bit integer.
+func (w *Writer) WriteInt8(v int8) error {
+	return w.WriteInt64(int64(v))
+}
+
+// WriteInt16 encodes and writes a 16-bit integer.
+func (w *Writer) WriteInt16(v int16) error {
+	return w.WriteInt64(int64(v))
+}
+
+// WriteInt32 encodes and writes a 32-bit integer.
+func (w *Writer) WriteInt32(v int32) error {
+	return w.WriteInt64(int64(v))
+}
+
 // WriteInt64 encodes and writes a 64-bit integer.
 func (w *Writer) WriteInt64(v int64) error {
 	if err := w.check(); err != nil {
@@ -178,6 +230,21 @@ func (w *Writer) WriteUint(v uint) error {
 	return w.WriteUint64(uint64(v))
 }
 
+// WriteUint8 encodes and writes an 8-bit unsigned integer.
+func (w *Writer) WriteUint8(v uint8) error {
+	return w.WriteUint64(uint64(v))
+}
+
+// WriteUint16 encodes and writes a 16-bit unsigned integer.
+func (w *Writer) WriteUint16(v uint16) error {
+	return w.WriteUint64(uint64(v))
+}
+
+// WriteUint32 encodes and writes a 32-bit unsigned integer.
+func (w *Writer) WriteUint32(v uint32) error {
+	return w.WriteUint64(uint64(v))
+}
+
 // WriteUint encodes and writes an unsigned integer.
 func (w *Writer) WriteUint64(v uint64) error {
 	if err := w.check(); err != nil {
@@ -189,6 +256,11 @@ func (w *Writer) WriteUint64(v uint64) error {
 	return nil
 }
 
+// WriteUintptr encodes and writes a pointer-sized unsigned integer.
+func (w *Writer) WriteUintptr(v uintptr) error {
+	return w.WriteUint64(uint64(v))
+}
+
 // WriteFloat32 encodes and writes a 32-bit float.
 func (w *Writer) WriteFloat32(v float32) error {
 	if err := w.check(); err != nil {
@@ -244,7 +316,24 @@ func (w *Writer) WriteNull() error {
 	return nil
 }
 
-// WriteMap writes a map.
+// WriteRaw writes bytes which are already JSON encoded, such as the output
+// of a MarshalJSON method.
+func (w *Writer) WriteRaw(b []byte) error {
+	if w.pos+len(b) > actualBufSize {
+		if err := w.Flush(); err != nil {
+			return err
+		}
+		if len(b) > actualBufSize {
+			_, err := w.w.Write(b)
+			return err
+		}
+	}
+	w.pos += copy(w.buf[w.pos:], b)
+	return nil
+}
+
+// WriteMap writes a map. Keys are written in sorted order if the writer
+// sorts keys and in random order otherwise.
 func (w *Writer) WriteMap(v map[string]interface{}) error {
 	if err := w.check(); err != nil {
 		return err
@@ -253,68 +342,25 @@ func (w *Writer) WriteMap(v map[string]interface{}) error {
 	w.buf[w.pos] = '{'
 	w.pos++
 
-	var index int
-	for key, value := range v {
-		if index > 0 {
-			w.buf[w.pos] = ','
-			w.pos++
+	if w.sortkeys {
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
 		}
-
-		// Write key and colon.
-		if err := w.WriteString(key); err != nil {
-			return err
-		}
-		w.buf[w.pos] = ':'
-		w.pos++
-
-		// Write value.
-		if value == nil {
-			if err := w.WriteNull(); err != nil {
+		sort.Strings(keys)
+		for index, key := range keys {
+			if err := w.writeMember(index, key, v[key]); err != nil {
 				return err
 			}
-
-		} else {
-			switch value := value.(type) {
-			case string:
-				if err := w.WriteString(value); err != nil {
-					return err
-				}
-			case int:
-				if err := w.WriteInt(value); err != nil {
-					return err
-				}
-			case int64:
-				if err := w.WriteInt64(value); err != nil {
-					return err
-				}
-			case uint:
-				if err := w.WriteUint(value); err != nil {
-					return err
-				}
-			case uint64:
-				if err := w.WriteUint64(value); err != nil {
-					return err
-				}
-			case float32:
-				if err := w.WriteFloat32(value); err != nil {
-					return err
-				}
-			case float64:
-				if err := w.WriteFloat64(value); err != nil {
-					return err
-				}
-			case bool:
-				if err := w.WriteBool(value); err != nil {
-					return err
-				}
-			case map[string]interface{}:
-				if err := w.WriteMap(value); err != nil {
-					return err
-				}
+		}
+	} else {
+		var index int
+		for key, value := range v {
+			if err := w.writeMember(index, key, value); err != nil {
+				return err
 			}
+			index++
 		}
-
-		index++
 	}
 
 	w.buf[w.pos] = '}'
@@ -322,3 +368,100 @@ func (w *Writer) WriteMap(v map[string]interface{}) error {
 
 	return nil
 }
+
+// writeMember writes a key and value of a map preceded by a comma if it is
+// not the first member.
+func (w *Writer) writeMember(index int, key string, value interface{}) error {
+	if index > 0 {
+		w.buf[w.pos] = ','
+		w.pos++
+	}
+
+	// Write key and colon.
+	if err := w.WriteString(key); err != nil {
+		return err
+	}
+	w.buf[w.pos] = ':'
+	w.pos++
+
+	// Write value.
+	return w.WriteInterface(value)
+}
+
+// WriteArray writes an array.
+func (w *Writer) WriteArray(v []interface{}) error {
+	if err := w.WriteByte('['); err != nil {
+		return err
+	}
+	for index, value := range v {
+		if index > 0 {
+			if err := w.WriteByte(','); err != nil {
+				return err
+			}
+		}
+		if err := w.WriteInterface(value); err != nil {
+			return err
+		}
+	}
+	return w.WriteByte(']')
+}
+
+// WriteInterface writes a value of any type. Types which the writer does
+// not handle directly are encoded with encoding/json.
+func (w *Writer) WriteInterface(v interface{}) error {
+	switch v := v.(type) {
+	case nil:
+		return w.WriteNull()
+	case string:
+		return w.WriteString(v)
+	case bool:
+		return w.WriteBool(v)
+	case int:
+		return w.WriteInt(v)
+	case int8:
+		return w.WriteInt8(v)
+	case int16:
+		return w.WriteInt16(v)
+	case int32:
+		return w.WriteInt32(v)
+	case int64:
+		return w.WriteInt64(v)
+	case uint:
+		return w.WriteUint(v)
+	case uint8:
+		return w.WriteUint8(v)
+	case uint16:
+		return w.WriteUint16(v)
+	case uint32:
+		return w.WriteUint32(v)
+	case uint64:
+		return w.WriteUint64(v)
+	case uintptr:
+		return w.WriteUintptr(v)
+	case float32:
+		return w.WriteFloat32(v)
+	case float64:
+		return w.WriteFloat64(v)
+	case []byte:
+		if v == nil {
+			return w.WriteNull()
+		}
+		return w.WriteBytes(v)
+	case map[string]interface{}:
+		if v == nil {
+			return w.WriteNull()
+		}
+		return w.WriteMap(v)
+	case []interface{}:
+		if v == nil {
+			return w.WriteNull()
+		}
+		return w.WriteArray(v)
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return w.WriteRaw(b)
+}
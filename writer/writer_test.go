@@ -2,6 +2,7 @@ package writer
 
 import (
 	"bytes"
+	"encoding/base64"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -109,6 +110,27 @@ func BenchmarkWriteString(b *testing.B) {
 	b.SetBytes(int64(len(s)))
 }
 
+// Ensures that a byte slice can be written as base64.
+func TestWriteBytes(t *testing.T) {
+	var b bytes.Buffer
+	w := NewWriter(&b)
+	assert.NoError(t, w.WriteBytes([]byte("foobar")))
+	assert.NoError(t, w.WriteBytes([]byte{}))
+	assert.NoError(t, w.Flush())
+	assert.Equal(t, b.String(), `"Zm9vYmFy"""`)
+}
+
+// Ensures that byte slices larger than the buffer can be written as base64.
+func TestWriteBytesLarge(t *testing.T) {
+	var b bytes.Buffer
+	v := bytes.Repeat([]byte("foo\x00\xff"), bufSize*3)
+	w := NewWriter(&b)
+	assert.NoError(t, w.WriteString("x"))
+	assert.NoError(t, w.WriteBytes(v))
+	assert.NoError(t, w.Flush())
+	assert.Equal(t, b.String(), `"x""`+base64.StdEncoding.EncodeToString(v)+`"`)
+}
+
 // Ensures that an int can be written.
 func TestWriteInt(t *testing.T) {
 	var b bytes.Buffer
@@ -127,6 +149,27 @@ func TestWriteUint(t *testing.T) {
 	assert.Equal(t, b.String(), `1230928137`)
 }
 
+// Ensures that sized integers can be written.
+func TestWriteSizedInts(t *testing.T) {
+	var b bytes.Buffer
+	w := NewWriter(&b)
+	assert.NoError(t, w.WriteInt8(-128))
+	assert.NoError(t, w.WriteByte(' '))
+	assert.NoError(t, w.WriteInt16(-32768))
+	assert.NoError(t, w.WriteByte(' '))
+	assert.NoError(t, w.WriteInt32(-2147483648))
+	assert.NoError(t, w.WriteByte(' '))
+	assert.NoError(t, w.WriteUint8(255))
+	assert.NoError(t, w.WriteByte(' '))
+	assert.NoError(t, w.WriteUint16(65535))
+	assert.NoError(t, w.WriteByte(' '))
+	assert.NoError(t, w.WriteUint32(4294967295))
+	assert.NoError(t, w.WriteByte(' '))
+	assert.NoError(t, w.WriteUintptr(1024))
+	assert.NoError(t, w.Flush())
+	assert.Equal(t, b.String(), `-128 -32768 -2147483648 255 65535 4294967295 1024`)
+}
+
 func BenchmarkWriteInt(b *testing.B) {
 	var buf bytes.Buffer
 	w := NewWriter(&buf)
@@ -228,6 +271,21 @@ func TestWriteNestedMap(t *testing.T) {
 	assert.Equal(t, b.String(), `{"foo":{"bar":"bat"}}`)
 }
 
+// Ensures that maps and nested maps are written in key order when sorted.
+func TestWriteSortedMap(t *testing.T) {
+	var b bytes.Buffer
+	w := NewWriter(&b)
+	w.SetSortKeys(true)
+	m := map[string]interface{}{
+		"z": 1,
+		"a": []interface{}{"x", map[string]interface{}{"d": 1, "c": 2}},
+		"m": map[string]interface{}{"y": true, "b": false},
+	}
+	assert.NoError(t, w.WriteInterface(m))
+	assert.NoError(t, w.Flush())
+	assert.Equal(t, b.String(), `{"a":["x",{"c":2,"d":1}],"m":{"b":false,"y":true},"z":1}`)
+}
+
 func BenchmarkWriteFloat32(b *testing.B) {
 	var buf bytes.Buffer
 	w := NewWriter(&buf)
@@ -263,6 +321,26 @@ func TestWriteByte(t *testing.T) {
 	assert.Equal(t, b.String(), `:`)
 }
 
+// Ensures that values of any type can be written.
+func TestWriteInterface(t *testing.T) {
+	var b bytes.Buffer
+	w := NewWriter(&b)
+	assert.NoError(t, w.WriteInterface([]interface{}{nil, "foo", int8(-1), uint16(2), 1.5, true, []byte("x"), map[string]interface{}{}, []string{"bar"}}))
+	assert.NoError(t, w.Flush())
+	assert.Equal(t, b.String(), `[null,"foo",-1,2,1.5,true,"eA==",{},["bar"]]`)
+}
+
+// Ensures that encoded JSON can be written as-is.
+func TestWriteRaw(t *testing.T) {
+	var b bytes.Buffer
+	w := NewWriter(&b)
+	assert.NoError(t, w.WriteByte('['))
+	assert.NoError(t, w.WriteRaw([]byte(`{"foo":"bar"}`)))
+	assert.NoError(t, w.WriteByte(']'))
+	assert.NoError(t, w.Flush())
+	assert.Equal(t, b.String(), `[{"foo":"bar"}]`)
+}
+
 // Ensures that a true boolean value can be written.
 func TestWriteTrue(t *testing.T) {
 	var b bytes.Buffer